@@ -0,0 +1,11 @@
+data "soc2bd_access_report" "quarterly" {}
+
+resource "local_file" "access_report_csv" {
+  filename = "access-report-${data.soc2bd_access_report.quarterly.content_hash}.csv"
+  content  = data.soc2bd_access_report.quarterly.csv
+}
+
+resource "local_file" "access_report_json" {
+  filename = "access-report-${data.soc2bd_access_report.quarterly.content_hash}.json"
+  content  = data.soc2bd_access_report.quarterly.json
+}
@@ -0,0 +1,9 @@
+data "soc2bd_effective_access" "database" {
+  address  = "db.internal.example.com"
+  protocol = "TCP"
+  port     = 5432
+}
+
+output "database_users" {
+  value = data.soc2bd_effective_access.database.user_ids
+}
@@ -4,3 +4,11 @@ data "soc2bd_resources" "foo" {
 
 # Resource names are not constrained to be unique within Soc2bd,
 # so it is possible that this data source will return multiple list items.
+
+# All the filters set must match, e.g. the web Resources in a CIDR block
+# the engineering Group has access to
+data "soc2bd_resources" "web" {
+  name_prefix         = "web-"
+  address_within_cidr = "10.0.0.0/16"
+  group_id            = "R3JvdXA6MzQ4OTE="
+}
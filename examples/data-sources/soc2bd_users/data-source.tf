@@ -1 +1,7 @@
 data "soc2bd_users" "all" {}
+
+data "soc2bd_users" "devops" {
+  role         = "DEVOPS"
+  state        = "ACTIVE"
+  email_domain = "example.com"
+}
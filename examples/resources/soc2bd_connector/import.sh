@@ -1 +1,4 @@
 terraform import soc2bd_connector.aws_connector Q29ubmVjdG9yOjI2NzM=
+
+# or by the remote network and connector names
+terraform import soc2bd_connector.aws_connector network:aws-prod/name:connector-1
@@ -12,5 +12,7 @@ resource "soc2bd_connector" "aws_connector" {
 }
 
 resource "soc2bd_connector_tokens" "aws_connector_tokens" {
-  connector_id = soc2bd_connector.aws_connector.id
+  connector_id  = soc2bd_connector.aws_connector.id
+  rotation_days = 90
+  rotate_before = 7
 }
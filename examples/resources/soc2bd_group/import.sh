@@ -1 +1,4 @@
 terraform import soc2bd_group.aws R3JvdXA6MzQ4OTE=
+
+# or by name
+terraform import soc2bd_group.aws name:aws-admins
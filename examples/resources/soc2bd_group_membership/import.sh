@@ -0,0 +1,2 @@
+# <group_id>/<user_id>
+terraform import soc2bd_group_membership.alice R3JvdXA6MzQ4OTE=/VXNlcjoxMjM0NQ==
@@ -0,0 +1,14 @@
+provider "soc2bd" {
+  api_token = "1234567890abcdef"
+  network   = "mynetwork"
+}
+
+resource "soc2bd_group" "shared" {
+  name             = "shared_group"
+  is_authoritative = false
+}
+
+resource "soc2bd_group_membership" "alice" {
+  group_id = soc2bd_group.shared.id
+  user_id  = "VXNlcjoxMjM0NQ=="
+}
@@ -1 +1,4 @@
 terraform import soc2bd_remote_network.network UmVtb3RlTmV0d29zaipgMKIkNg==
+
+# or by name
+terraform import soc2bd_remote_network.network name:aws-prod
@@ -1 +1,8 @@
 terraform import soc2bd_resource.resource UmVzb3VyY2U6MzQwNDQ3
+
+# or by name
+terraform import soc2bd_resource.resource name:prod-db
+
+# or by the remote network name and the resource address or name
+terraform import soc2bd_resource.resource network:aws-prod/address:10.0.0.0/16
+terraform import soc2bd_resource.resource network:aws-prod/name:prod-db
@@ -0,0 +1,2 @@
+# <resource_id>/<group_id or service_account_id>
+terraform import soc2bd_resource_access.dba UmVzb3VyY2U6MzQwNDQ3/R3JvdXA6MzQ4OTE=
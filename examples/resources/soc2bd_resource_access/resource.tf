@@ -0,0 +1,33 @@
+provider "soc2bd" {
+  api_token = "1234567890abcdef"
+  network   = "mynetwork"
+}
+
+resource "soc2bd_remote_network" "aws_network" {
+  name = "aws_remote_network"
+}
+
+resource "soc2bd_resource" "database" {
+  name              = "database"
+  address           = "db.internal.int"
+  remote_network_id = soc2bd_remote_network.aws_network.id
+  is_authoritative  = false
+}
+
+resource "soc2bd_group" "dba" {
+  name = "dba_group"
+}
+
+resource "soc2bd_service_account" "github_actions_prod" {
+  name = "Github Actions PROD"
+}
+
+resource "soc2bd_resource_access" "dba" {
+  resource_id = soc2bd_resource.database.id
+  group_id    = soc2bd_group.dba.id
+}
+
+resource "soc2bd_resource_access" "github_actions_prod" {
+  resource_id        = soc2bd_resource.database.id
+  service_account_id = soc2bd_service_account.github_actions_prod.id
+}
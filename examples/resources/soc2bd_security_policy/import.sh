@@ -0,0 +1,4 @@
+terraform import soc2bd_security_policy.strict U2VjdXJpdHlQb2xpY3k6MTIzNDU=
+
+# or by name
+terraform import soc2bd_security_policy.strict name:strict
@@ -0,0 +1,23 @@
+provider "soc2bd" {
+  api_token = "1234567890abcdef"
+  network   = "mynetwork"
+}
+
+resource "soc2bd_group" "engineering" {
+  name = "engineering"
+}
+
+resource "soc2bd_security_policy" "strict" {
+  name                   = "strict"
+  require_mfa            = true
+  mfa_methods            = ["TOTP", "WEBAUTHN"]
+  session_lifetime_hours = 12
+
+  device_posture {
+    require_screen_lock       = true
+    require_disk_encryption   = true
+    allowed_operating_systems = ["MACOS", "WINDOWS", "LINUX"]
+  }
+
+  group_ids = [soc2bd_group.engineering.id]
+}
@@ -0,0 +1,4 @@
+terraform import soc2bd_service_account.github_actions_prod U2VydmljZUFjY291bnQ6MTIzNA==
+
+# or by name
+terraform import soc2bd_service_account.github_actions_prod "name:Github Actions PROD"
@@ -10,4 +10,5 @@ resource "soc2bd_service_account" "github_actions_prod" {
 resource "soc2bd_service_account_key" "github_key" {
   name = "Github Actions PROD key"
   service_account_id = soc2bd_service_account.github_actions_prod.id
+  expiration_time = 90
 }
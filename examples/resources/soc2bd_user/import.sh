@@ -0,0 +1,4 @@
+terraform import soc2bd_user.user VXNlcjoxMjM0
+
+# or by email
+terraform import soc2bd_user.user email:alice@corp.com
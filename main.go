@@ -1,10 +1,17 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/generate"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 )
 
 var (
@@ -12,9 +19,35 @@ var (
 )
 
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: func() *schema.Provider {
-			return soc2bd.Provider(version)
-		},
-	})
+	if len(os.Args) > 1 && os.Args[1] == generate.Command {
+		if err := generate.Run(context.Background(), os.Args[2:], version, os.Stdout); err != nil {
+			if !errors.Is(err, flag.ErrHelp) {
+				fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+				os.Exit(1)
+			}
+		}
+
+		return
+	}
+
+	var debug bool
+
+	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	serverFactory, err := soc2bd.ProviderServerFactory(ctx, soc2bd.Provider(version), version)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf6server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
+	}
+
+	if err := tf6server.Serve(soc2bd.ProviderAddress, serverFactory, serveOpts...); err != nil {
+		log.Fatal(err)
+	}
 }
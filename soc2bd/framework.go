@@ -0,0 +1,188 @@
+package soc2bd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider/resource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// frameworkProvider serves the resources migrated to the plugin framework, it is muxed with the SDK provider
+// so both share the same provider configuration.
+type frameworkProvider struct {
+	version string
+}
+
+var _ provider.Provider = &frameworkProvider{}
+
+func NewFrameworkProvider(version string) provider.Provider {
+	return &frameworkProvider{version: version}
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "soc2bd"
+	resp.Version = p.version
+}
+
+// Schema mirrors the SDK provider options, the muxed providers must have identical schemas.
+func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	options := providerOptions()
+	attributes := make(map[string]schema.Attribute, len(options))
+
+	for name, option := range options {
+		switch option.Type { //nolint:exhaustive
+		case sdkschema.TypeString:
+			attributes[name] = schema.StringAttribute{Optional: option.Optional, Sensitive: option.Sensitive, Description: option.Description}
+		case sdkschema.TypeInt:
+			attributes[name] = schema.Int64Attribute{Optional: option.Optional, Sensitive: option.Sensitive, Description: option.Description}
+		case sdkschema.TypeFloat:
+			attributes[name] = schema.Float64Attribute{Optional: option.Optional, Sensitive: option.Sensitive, Description: option.Description}
+		case sdkschema.TypeBool:
+			attributes[name] = schema.BoolAttribute{Optional: option.Optional, Sensitive: option.Sensitive, Description: option.Description}
+		default:
+			panic(fmt.Sprintf("unsupported provider option type %s for %s", option.Type, name))
+		}
+	}
+
+	resp.Schema = schema.Schema{Attributes: attributes}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	options := providerOptions()
+	values := make(map[string]interface{}, len(options))
+
+	for name, option := range options {
+		value, diags := configValue(ctx, req.Config, name, option)
+		resp.Diagnostics.Append(diags...)
+
+		values[name] = value
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg := providerConfig{
+		apiToken:            values[attr.APIToken].(string),
+		apiTokenFile:        values[attr.APITokenFile].(string),
+		apiTokenCommand:     values[attr.APITokenCommand].(string),
+		apiTokenCommandTTL:  values[attr.APITokenCommandTTL].(int),
+		apiTokenAgentSocket: values[attr.APITokenAgentSocket].(string),
+		network:             values[attr.Network].(string),
+		url:                 values[attr.URL].(string),
+		httpTimeout:         values[attr.HTTPTimeout].(int),
+		httpMaxRetry:        values[attr.HTTPMaxRetry].(int),
+		httpRateLimit:       values[attr.HTTPRateLimit].(float64),
+		httpRateLimitBurst:  values[attr.HTTPRateLimitBurst].(int),
+		deletionProtection:  values[attr.DeletionProtection].(string),
+		caCertPEM:           values[attr.CACertPEM].(string),
+		caCertFile:          values[attr.CACertFile].(string),
+		clientCert:          values[attr.ClientCert].(string),
+		clientKey:           values[attr.ClientKey].(string),
+		proxyURL:            values[attr.ProxyURL].(string),
+		noProxy:             values[attr.NoProxy].(string),
+		insecureSkipVerify:  values[attr.InsecureSkipVerify].(bool),
+	}
+
+	meta, err := configuredMetas.get(ctx, cfg, p.version)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create Soc2bd client", clientErrorDetail(err))
+
+		return
+	}
+
+	resp.ResourceData = meta
+	resp.DataSourceData = meta
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() tfresource.Resource {
+	return []func() tfresource.Resource{
+		resource.NewResourceResource,
+	}
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+// configValue reads the provider option from the configuration,
+// falling back to its default like the SDK does when it's not set.
+func configValue(ctx context.Context, config tfsdk.Config, name string, option *sdkschema.Schema) (interface{}, diag.Diagnostics) { //nolint:cyclop
+	var (
+		value interface{}
+		isSet bool
+		diags diag.Diagnostics
+	)
+
+	switch option.Type { //nolint:exhaustive
+	case sdkschema.TypeString:
+		var val types.String
+		diags = config.GetAttribute(ctx, path.Root(name), &val)
+		value, isSet = val.ValueString(), !val.IsNull() && !val.IsUnknown()
+	case sdkschema.TypeInt:
+		var val types.Int64
+		diags = config.GetAttribute(ctx, path.Root(name), &val)
+		value, isSet = int(val.ValueInt64()), !val.IsNull() && !val.IsUnknown()
+	case sdkschema.TypeFloat:
+		var val types.Float64
+		diags = config.GetAttribute(ctx, path.Root(name), &val)
+		value, isSet = val.ValueFloat64(), !val.IsNull() && !val.IsUnknown()
+	case sdkschema.TypeBool:
+		var val types.Bool
+		diags = config.GetAttribute(ctx, path.Root(name), &val)
+		value, isSet = val.ValueBool(), !val.IsNull() && !val.IsUnknown()
+	}
+
+	if diags.HasError() || isSet || option.DefaultFunc == nil {
+		return value, diags
+	}
+
+	defaultValue, err := option.DefaultFunc()
+	if err != nil {
+		diags.AddAttributeError(path.Root(name), "Invalid provider option default", err.Error())
+
+		return value, diags
+	}
+
+	if defaultValue == nil {
+		return value, diags
+	}
+
+	value, err = parseDefaultValue(option.Type, defaultValue)
+	if err != nil {
+		diags.AddAttributeError(path.Root(name), "Invalid provider option value",
+			fmt.Sprintf("failed to parse %s value `%v`: %s", name, defaultValue, err))
+	}
+
+	return value, diags
+}
+
+// parseDefaultValue converts the defaults, which are strings when read from environment variables.
+func parseDefaultValue(valueType sdkschema.ValueType, defaultValue interface{}) (interface{}, error) {
+	str, ok := defaultValue.(string)
+	if !ok {
+		return defaultValue, nil
+	}
+
+	switch valueType { //nolint:exhaustive
+	case sdkschema.TypeInt:
+		return strconv.Atoi(str) //nolint:wrapcheck
+	case sdkschema.TypeFloat:
+		return strconv.ParseFloat(str, 64) //nolint:wrapcheck
+	case sdkschema.TypeBool:
+		return strconv.ParseBool(str) //nolint:wrapcheck
+	default:
+		return str, nil
+	}
+}
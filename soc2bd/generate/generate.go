@@ -0,0 +1,118 @@
+// Package generate implements the `generate` command of the provider binary,
+// exporting an existing Soc2bd network as Terraform configuration.
+package generate
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+)
+
+// Command is the name of the subcommand dispatched to Run.
+const Command = "generate"
+
+const filePermissions = 0o644
+
+var ErrFileExists = errors.New("file already exists, use -force to overwrite it")
+
+type options struct {
+	dir         string
+	network     string
+	url         string
+	withImports bool
+	force       bool
+}
+
+func parseOptions(args []string, output io.Writer) (*options, error) {
+	var opts options
+
+	flags := flag.NewFlagSet(Command, flag.ContinueOnError)
+	flags.SetOutput(output)
+	flags.Usage = func() {
+		fmt.Fprintf(output, "Usage: terraform-provider-soc2bd %s [options]\n\n"+
+			"Writes the remote networks, connectors, users, groups, service accounts and resources\n"+
+			"of a Soc2bd network as Terraform configuration, with import blocks to adopt them.\n"+
+			"The API token is read from the %s environment variable.\n\nOptions:\n", Command, soc2bd.EnvAPIToken)
+		flags.PrintDefaults()
+	}
+
+	flags.StringVar(&opts.dir, "dir", ".", "directory to write the .tf files to")
+	flags.StringVar(&opts.network, "network", os.Getenv(soc2bd.EnvNetwork), "Soc2bd network ID, defaults to "+soc2bd.EnvNetwork)
+	flags.StringVar(&opts.url, "url", envOrDefault(soc2bd.EnvURL, soc2bd.DefaultURL), "Soc2bd URL, defaults to "+soc2bd.EnvURL)
+	flags.BoolVar(&opts.withImports, "import", true, "write import blocks for the generated resources")
+	flags.BoolVar(&opts.force, "force", false, "overwrite existing files")
+
+	if err := flags.Parse(args); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &opts, nil
+}
+
+func envOrDefault(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+
+	return defaultValue
+}
+
+// Run reads the Soc2bd network and writes its configuration, args are the command line arguments after the command name.
+func Run(ctx context.Context, args []string, version string, output io.Writer) error {
+	opts, err := parseOptions(args, output)
+	if err != nil {
+		return err
+	}
+
+	c := client.NewClient(
+		client.WithNetwork(opts.network),
+		client.WithURL(opts.url),
+		client.WithUserAgent(fmt.Sprintf("Soc2bdTF/%s", version)),
+	)
+
+	t, err := readTenant(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	files := newWriter().render(t, opts.withImports)
+
+	if err := writeFiles(opts.dir, files, opts.force); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(output, "Generated %d remote networks, %d connectors, %d users, %d groups, %d service accounts and %d resources in %s\n",
+		len(t.remoteNetworks), len(t.connectors), len(t.users), len(t.groups), len(t.serviceAccounts), len(t.resources), opts.dir)
+
+	return nil
+}
+
+func writeFiles(dir string, files []file, force bool) error {
+	if !force {
+		for _, f := range files {
+			path := filepath.Join(dir, f.name)
+			if _, err := os.Stat(path); err == nil {
+				return fmt.Errorf("%s: %w", path, ErrFileExists)
+			}
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gomnd
+		return err //nolint:wrapcheck
+	}
+
+	for _, f := range files {
+		if err := os.WriteFile(filepath.Join(dir, f.name), f.content, filePermissions); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package generate
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test/fake"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabel(t *testing.T) {
+	w := newWriter()
+
+	assert.Equal(t, "aws_prod", w.label("soc2bd_remote_network", "AWS Prod"))
+	assert.Equal(t, "aws_prod_2", w.label("soc2bd_remote_network", "aws-prod"))
+	assert.Equal(t, "aws_prod", w.label("soc2bd_group", "aws.prod"))
+	assert.Equal(t, "_10_0_0_1", w.label("soc2bd_resource", "10.0.0.1"))
+	assert.Equal(t, "_", w.label("soc2bd_resource", "***"))
+	assert.Equal(t, "alice_corp_com", w.label("soc2bd_user", "alice@corp.com"))
+}
+
+func newTenant(t *testing.T) *fake.Server {
+	t.Helper()
+
+	srv := fake.NewServer()
+	t.Cleanup(srv.Close)
+
+	c := client.NewClient(
+		client.WithURL(srv.URL),
+		client.WithAPIToken(fake.APIToken),
+		client.WithNetwork(fake.Network),
+		client.WithHTTPTimeout(time.Second),
+		client.WithHTTPMaxRetry(0),
+	)
+	ctx := context.Background()
+
+	network, err := c.CreateRemoteNetwork(ctx, &model.RemoteNetwork{Name: "AWS Prod", Location: model.LocationAWS})
+	require.NoError(t, err)
+
+	_, err = c.CreateConnector(ctx, &model.Connector{Name: "connector-1", NetworkID: network.ID})
+	require.NoError(t, err)
+
+	user, err := c.CreateUser(ctx, &model.User{Email: "alice@corp.com", FirstName: "Alice", Role: model.UserRoleMember})
+	require.NoError(t, err)
+
+	syncedUserID := srv.AddSyncedUser("bob@corp.com", "Bob", "Synced")
+
+	group, err := c.CreateGroup(ctx, &model.Group{Name: "Devs", Users: []string{user.ID, syncedUserID}})
+	require.NoError(t, err)
+
+	account, err := c.CreateServiceAccount(ctx, "CI")
+	require.NoError(t, err)
+
+	resource, err := c.CreateResource(ctx, &model.Resource{
+		Name:            "prod-db",
+		Address:         "10.0.0.0/16",
+		RemoteNetworkID: network.ID,
+		Groups:          []string{group.ID},
+		Protocols: &model.Protocols{
+			AllowIcmp: true,
+			TCP: &model.Protocol{
+				Policy: model.PolicyRestricted,
+				Ports:  []*model.PortRange{{Start: 80, End: 80}, {Start: 82, End: 83}},
+			},
+			UDP: model.NewProtocol(model.PolicyDenyAll, nil),
+		},
+	})
+	require.NoError(t, err)
+
+	resource.ServiceAccounts = []string{account.ID}
+	require.NoError(t, c.AddResourceServiceAccountIDs(ctx, resource))
+
+	t.Setenv(soc2bd.EnvURL, srv.URL)
+	t.Setenv(soc2bd.EnvNetwork, fake.Network)
+	t.Setenv(soc2bd.EnvAPIToken, fake.APIToken)
+
+	return srv
+}
+
+func readFile(t *testing.T, dir, name string) string {
+	t.Helper()
+
+	content, err := os.ReadFile(filepath.Join(dir, name))
+	require.NoError(t, err)
+
+	return string(content)
+}
+
+func TestRun(t *testing.T) {
+	t.Run("Test Soc2bd Generate : Run", func(t *testing.T) {
+		newTenant(t)
+
+		dir := t.TempDir()
+		var output bytes.Buffer
+
+		require.NoError(t, Run(context.Background(), []string{"-dir", dir}, "test", &output))
+		assert.Contains(t, output.String(), "Generated 1 remote networks, 1 connectors, 1 users, 1 groups, 1 service accounts and 1 resources")
+
+		assert.Contains(t, readFile(t, dir, "remote_networks.tf"), `resource "soc2bd_remote_network" "aws_prod" {
+  name     = "AWS Prod"
+  location = "AWS"
+}`)
+
+		assert.Contains(t, readFile(t, dir, "connectors.tf"), `resource "soc2bd_connector" "connector_1" {
+  name                   = "connector-1"
+  remote_network_id      = soc2bd_remote_network.aws_prod.id
+  status_updates_enabled = true
+}`)
+
+		users := readFile(t, dir, "users.tf")
+		assert.Contains(t, users, `resource "soc2bd_user" "alice_corp_com" {
+  email      = "alice@corp.com"
+  first_name = "Alice"`)
+		assert.NotContains(t, users, "bob@corp.com")
+
+		groups := readFile(t, dir, "groups.tf")
+		assert.Contains(t, groups, `resource "soc2bd_group" "devs" {
+  name     = "Devs"
+  user_ids = [soc2bd_user.alice_corp_com.id, "`)
+
+		assert.Contains(t, readFile(t, dir, "service_accounts.tf"), `resource "soc2bd_service_account" "ci" {
+  name = "CI"
+}`)
+
+		resources := readFile(t, dir, "resources.tf")
+		assert.Contains(t, resources, `resource "soc2bd_resource" "prod_db" {
+  name                        = "prod-db"
+  address                     = "10.0.0.0/16"
+  remote_network_id           = soc2bd_remote_network.aws_prod.id
+  is_visible                  = true
+  is_browser_shortcut_enabled = true
+
+  protocols {`)
+		assert.Contains(t, resources, `    tcp {
+      policy = "RESTRICTED"
+      ports  = ["80", "82-83"]
+    }`)
+		// the API returns DENY_ALL as RESTRICTED without ports
+		assert.Contains(t, resources, `    udp {
+      policy = "DENY_ALL"
+    }`)
+		assert.Contains(t, resources, `  access {
+    group_ids           = [soc2bd_group.devs.id]
+    service_account_ids = [soc2bd_service_account.ci.id]
+  }`)
+
+		imports := readFile(t, dir, "imports.tf")
+		for _, address := range []string{
+			"soc2bd_remote_network.aws_prod", "soc2bd_connector.connector_1", "soc2bd_user.alice_corp_com",
+			"soc2bd_group.devs", "soc2bd_service_account.ci", "soc2bd_resource.prod_db",
+		} {
+			assert.Contains(t, imports, "to = "+address+"\n")
+		}
+
+		err := Run(context.Background(), []string{"-dir", dir}, "test", &output)
+		assert.ErrorIs(t, err, ErrFileExists)
+
+		require.NoError(t, Run(context.Background(), []string{"-dir", dir, "-force"}, "test", &output))
+	})
+}
+
+func TestRunWithoutImports(t *testing.T) {
+	t.Run("Test Soc2bd Generate : Run Without Imports", func(t *testing.T) {
+		newTenant(t)
+
+		dir := t.TempDir()
+
+		require.NoError(t, Run(context.Background(), []string{"-dir", dir, "-import=false"}, "test", &bytes.Buffer{}))
+
+		_, err := os.Stat(filepath.Join(dir, "imports.tf"))
+		assert.True(t, os.IsNotExist(err))
+	})
+}
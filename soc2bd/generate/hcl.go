@@ -0,0 +1,303 @@
+package generate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider/resource"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// file is a generated .tf file.
+type file struct {
+	name    string
+	content []byte
+}
+
+// writer renders the tenant objects as Terraform configuration,
+// referencing generated objects by address instead of literal IDs.
+type writer struct {
+	// addresses maps object IDs to their Terraform resource addresses.
+	addresses map[string]hcl.Traversal
+	labels    map[string]map[string]bool
+	imports   *hclwrite.File
+}
+
+func newWriter() *writer {
+	return &writer{
+		addresses: make(map[string]hcl.Traversal),
+		labels:    make(map[string]map[string]bool),
+		imports:   hclwrite.NewEmptyFile(),
+	}
+}
+
+// label returns a unique resource label derived from the object name.
+func (w *writer) label(resourceType, name string) string {
+	label := strings.Trim(invalidLabelChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" || label[0] >= '0' && label[0] <= '9' {
+		label = "_" + label
+	}
+
+	used := w.labels[resourceType]
+	if used == nil {
+		used = make(map[string]bool)
+		w.labels[resourceType] = used
+	}
+
+	unique := label
+	for i := 2; used[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+
+	used[unique] = true
+
+	return unique
+}
+
+// register assigns a label to the object, so it can be referenced before its block is written:
+// objects are registered first, then rendered.
+func (w *writer) register(resourceType, id, name string) {
+	w.addresses[id] = hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: w.label(resourceType, name)},
+	}
+}
+
+func (w *writer) address(id string) hcl.Traversal {
+	return w.addresses[id]
+}
+
+// newResource starts the resource block of the registered object and adds its import block.
+func (w *writer) newResource(body *hclwrite.Body, id string) *hclwrite.Body {
+	address := w.address(id)
+
+	block := body.AppendNewBlock("resource", []string{address.RootName(), address[1].(hcl.TraverseAttr).Name})
+	body.AppendNewline()
+
+	importBody := w.imports.Body().AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", address)
+	importBody.SetAttributeValue(attr.ID, cty.StringVal(id))
+	w.imports.Body().AppendNewline()
+
+	return block.Body()
+}
+
+// reference returns the `<address>.id` expression of a generated object, or the literal ID otherwise.
+func (w *writer) reference(id string) hclwrite.Tokens {
+	address, ok := w.addresses[id]
+	if !ok {
+		return hclwrite.TokensForValue(cty.StringVal(id))
+	}
+
+	return hclwrite.TokensForTraversal(append(address, hcl.TraverseAttr{Name: attr.ID}))
+}
+
+func (w *writer) setReference(body *hclwrite.Body, name, id string) {
+	body.SetAttributeRaw(name, w.reference(id))
+}
+
+func (w *writer) setReferences(body *hclwrite.Body, name string, ids []string) {
+	elems := make([]hclwrite.Tokens, 0, len(ids))
+	for _, id := range ids {
+		elems = append(elems, w.reference(id))
+	}
+
+	body.SetAttributeRaw(name, hclwrite.TokensForTuple(elems))
+}
+
+func (w *writer) render(t *tenant, withImports bool) []file {
+	for _, network := range t.remoteNetworks {
+		w.register(resource.Soc2bdRemoteNetwork, network.ID, network.Name)
+	}
+
+	for _, connector := range t.connectors {
+		w.register(resource.Soc2bdConnector, connector.ID, connector.Name)
+	}
+
+	for _, user := range t.users {
+		w.register(resource.Soc2bdUser, user.ID, user.Email)
+	}
+
+	for _, group := range t.groups {
+		w.register(resource.Soc2bdGroup, group.ID, group.Name)
+	}
+
+	for _, account := range t.serviceAccounts {
+		w.register(resource.Soc2bdServiceAccount, account.ID, account.Name)
+	}
+
+	for _, res := range t.resources {
+		w.register(resource.Soc2bdResource, res.ID, res.Name)
+	}
+
+	files := []file{
+		{name: "remote_networks.tf", content: w.renderRemoteNetworks(t.remoteNetworks)},
+		{name: "connectors.tf", content: w.renderConnectors(t.connectors)},
+		{name: "users.tf", content: w.renderUsers(t.users)},
+		{name: "groups.tf", content: w.renderGroups(t.groups)},
+		{name: "service_accounts.tf", content: w.renderServiceAccounts(t.serviceAccounts)},
+		{name: "resources.tf", content: w.renderResources(t.resources)},
+	}
+
+	if withImports {
+		files = append(files, file{name: "imports.tf", content: w.imports.Bytes()})
+	}
+
+	return files
+}
+
+func (w *writer) renderRemoteNetworks(networks []*model.RemoteNetwork) []byte {
+	f := hclwrite.NewEmptyFile()
+
+	for _, network := range networks {
+		body := w.newResource(f.Body(), network.ID)
+		body.SetAttributeValue(attr.Name, cty.StringVal(network.Name))
+
+		if network.Location != "" {
+			body.SetAttributeValue(attr.Location, cty.StringVal(network.Location))
+		}
+	}
+
+	return f.Bytes()
+}
+
+func (w *writer) renderConnectors(connectors []*model.Connector) []byte {
+	f := hclwrite.NewEmptyFile()
+
+	for _, connector := range connectors {
+		body := w.newResource(f.Body(), connector.ID)
+		body.SetAttributeValue(attr.Name, cty.StringVal(connector.Name))
+		w.setReference(body, attr.RemoteNetworkID, connector.NetworkID)
+
+		if connector.StatusUpdatesEnabled != nil {
+			body.SetAttributeValue(attr.StatusUpdatesEnabled, cty.BoolVal(*connector.StatusUpdatesEnabled))
+		}
+	}
+
+	return f.Bytes()
+}
+
+func (w *writer) renderUsers(users []*model.User) []byte {
+	f := hclwrite.NewEmptyFile()
+
+	for _, user := range users {
+		body := w.newResource(f.Body(), user.ID)
+		body.SetAttributeValue(attr.Email, cty.StringVal(user.Email))
+
+		if user.FirstName != "" {
+			body.SetAttributeValue(attr.FirstName, cty.StringVal(user.FirstName))
+		}
+
+		if user.LastName != "" {
+			body.SetAttributeValue(attr.LastName, cty.StringVal(user.LastName))
+		}
+
+		if user.Role != "" {
+			body.SetAttributeValue(attr.Role, cty.StringVal(user.Role))
+		}
+	}
+
+	return f.Bytes()
+}
+
+func (w *writer) renderGroups(groups []*model.Group) []byte {
+	f := hclwrite.NewEmptyFile()
+
+	for _, group := range groups {
+		body := w.newResource(f.Body(), group.ID)
+		body.SetAttributeValue(attr.Name, cty.StringVal(group.Name))
+
+		if len(group.Users) > 0 {
+			w.setReferences(body, attr.UserIDs, group.Users)
+		}
+	}
+
+	return f.Bytes()
+}
+
+func (w *writer) renderServiceAccounts(accounts []*model.ServiceAccount) []byte {
+	f := hclwrite.NewEmptyFile()
+
+	for _, account := range accounts {
+		body := w.newResource(f.Body(), account.ID)
+		body.SetAttributeValue(attr.Name, cty.StringVal(account.Name))
+	}
+
+	return f.Bytes()
+}
+
+func (w *writer) renderResources(resources []*model.Resource) []byte {
+	f := hclwrite.NewEmptyFile()
+
+	for _, res := range resources {
+		body := w.newResource(f.Body(), res.ID)
+		body.SetAttributeValue(attr.Name, cty.StringVal(res.Name))
+		body.SetAttributeValue(attr.Address, cty.StringVal(res.Address))
+		w.setReference(body, attr.RemoteNetworkID, res.RemoteNetworkID)
+
+		if res.Alias != nil && *res.Alias != "" {
+			body.SetAttributeValue(attr.Alias, cty.StringVal(*res.Alias))
+		}
+
+		if res.IsVisible != nil {
+			body.SetAttributeValue(attr.IsVisible, cty.BoolVal(*res.IsVisible))
+		}
+
+		if res.IsBrowserShortcutEnabled != nil {
+			body.SetAttributeValue(attr.IsBrowserShortcutEnabled, cty.BoolVal(*res.IsBrowserShortcutEnabled))
+		}
+
+		if res.Protocols != nil {
+			body.AppendNewline()
+			renderProtocols(body.AppendNewBlock(attr.Protocols, nil).Body(), res.Protocols)
+		}
+
+		if len(res.Groups) > 0 || len(res.ServiceAccounts) > 0 {
+			body.AppendNewline()
+
+			access := body.AppendNewBlock(attr.Access, nil).Body()
+
+			if len(res.Groups) > 0 {
+				w.setReferences(access, attr.GroupIDs, res.Groups)
+			}
+
+			if len(res.ServiceAccounts) > 0 {
+				w.setReferences(access, attr.ServiceAccountIDs, res.ServiceAccounts)
+			}
+		}
+	}
+
+	return f.Bytes()
+}
+
+func renderProtocols(body *hclwrite.Body, protocols *model.Protocols) {
+	body.SetAttributeValue(attr.AllowIcmp, cty.BoolVal(protocols.AllowIcmp))
+
+	renderProtocol(body, attr.TCP, protocols.TCP)
+	renderProtocol(body, attr.UDP, protocols.UDP)
+}
+
+func renderProtocol(body *hclwrite.Body, name string, protocol *model.Protocol) {
+	if protocol == nil {
+		protocol = model.DefaultProtocol()
+	}
+
+	block := body.AppendNewBlock(name, nil).Body()
+	block.SetAttributeValue(attr.Policy, cty.StringVal(protocol.EffectivePolicy()))
+
+	if ports := protocol.PortsToString(); len(ports) > 0 {
+		values := make([]cty.Value, 0, len(ports))
+		for _, port := range ports {
+			values = append(values, cty.StringVal(port))
+		}
+
+		block.SetAttributeValue(attr.Ports, cty.ListVal(values))
+	}
+}
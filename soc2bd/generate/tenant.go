@@ -0,0 +1,120 @@
+package generate
+
+import (
+	"context"
+	"sort"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/utils"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+)
+
+// tenant holds the objects of a Soc2bd network that can be managed by Terraform.
+type tenant struct {
+	remoteNetworks  []*model.RemoteNetwork
+	connectors      []*model.Connector
+	groups          []*model.Group
+	users           []*model.User
+	serviceAccounts []*model.ServiceAccount
+	resources       []*model.Resource
+}
+
+// readTenant reads all the tenant objects, skipping the ones Terraform can't manage:
+// synced and system groups and synced users.
+func readTenant(ctx context.Context, c *client.Client) (*tenant, error) {
+	var (
+		result tenant
+		err    error
+	)
+
+	if result.remoteNetworks, err = c.ReadRemoteNetworks(ctx); client.IgnoreEmptyResult(err) != nil {
+		return nil, err
+	}
+
+	if result.connectors, err = c.ReadConnectors(ctx); client.IgnoreEmptyResult(err) != nil {
+		return nil, err
+	}
+
+	if result.groups, err = readManualGroups(ctx, c); err != nil {
+		return nil, err
+	}
+
+	users, err := c.ReadUsers(ctx)
+	if client.IgnoreEmptyResult(err) != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		if user.Type == model.UserTypeManual {
+			result.users = append(result.users, user)
+		}
+	}
+
+	if result.serviceAccounts, err = c.ReadServiceAccounts(ctx); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if result.resources, err = readResources(ctx, c); err != nil {
+		return nil, err
+	}
+
+	result.sort()
+
+	return &result, nil
+}
+
+// readManualGroups reads the manual groups with all their users, the others are managed outside of Terraform.
+func readManualGroups(ctx context.Context, c *client.Client) ([]*model.Group, error) {
+	groups, err := c.ReadGroups(ctx, nil)
+	if client.IgnoreEmptyResult(err) != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return utils.Filter(groups, func(group *model.Group) bool {
+		return group.Type == model.GroupTypeManual
+	}), nil
+}
+
+// readResources reads the resources with their access, as the resources list doesn't return it.
+func readResources(ctx context.Context, c *client.Client) ([]*model.Resource, error) {
+	resources, err := c.ReadResources(ctx)
+	if client.IgnoreEmptyResult(err) != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	result := make([]*model.Resource, 0, len(resources))
+
+	for _, resource := range resources {
+		resource, err := c.ReadResource(ctx, resource.ID)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		result = append(result, resource)
+	}
+
+	return result, nil
+}
+
+// sort orders the objects by name, so the generated files don't change between runs.
+func (t *tenant) sort() {
+	sortByName(t.remoteNetworks, func(n *model.RemoteNetwork) (string, string) { return n.Name, n.ID })
+	sortByName(t.connectors, func(c *model.Connector) (string, string) { return c.Name, c.ID })
+	sortByName(t.groups, func(g *model.Group) (string, string) { return g.Name, g.ID })
+	sortByName(t.users, func(u *model.User) (string, string) { return u.Email, u.ID })
+	sortByName(t.serviceAccounts, func(s *model.ServiceAccount) (string, string) { return s.Name, s.ID })
+	sortByName(t.resources, func(r *model.Resource) (string, string) { return r.Name, r.ID })
+}
+
+func sortByName[T any](items []T, key func(item T) (name, id string)) {
+	sort.SliceStable(items, func(i, j int) bool {
+		nameI, idI := key(items[i])
+		nameJ, idJ := key(items[j])
+
+		if nameI != nameJ {
+			return nameI < nameJ
+		}
+
+		return idI < idJ
+	})
+}
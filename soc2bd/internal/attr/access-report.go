@@ -0,0 +1,9 @@
+package attr
+
+const (
+	GeneratedAt = "generated_at"
+	ContentHash = "content_hash"
+	CSV         = "csv"
+	JSON        = "json"
+	RowCount    = "row_count"
+)
@@ -1,9 +1,10 @@
 package attr
 
 const (
-	ID              = "id"
-	Name            = "name"
-	RemoteNetworkID = "remote_network_id"
-	Type            = "type"
-	IsActive        = "is_active"
+	ID                 = "id"
+	Name               = "name"
+	RemoteNetworkID    = "remote_network_id"
+	Type               = "type"
+	IsActive           = "is_active"
+	DeletionProtection = "deletion_protection"
 )
@@ -5,4 +5,7 @@ const (
 	Keepers      = "keepers"
 	AccessToken  = "access_token"
 	RefreshToken = "refresh_token"
+	RotationDays = "rotation_days"
+	RotateBefore = "rotate_before"
+	IssuedAt     = "issued_at"
 )
@@ -3,4 +3,9 @@ package attr
 const (
 	StatusUpdatesEnabled = "status_updates_enabled"
 	Connectors           = "connectors"
+	LastHeartbeatAt      = "last_heartbeat_at"
+	Version              = "version"
+	Hostname             = "hostname"
+	PublicIP             = "public_ip"
+	PrivateIPs           = "private_ips"
 )
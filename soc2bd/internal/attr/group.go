@@ -2,6 +2,8 @@ package attr
 
 const (
 	UserIDs          = "user_ids"
+	GroupID          = "group_id"
+	UserID           = "user_id"
 	SecurityPolicyID = "security_policy_id"
 	Groups           = "groups"
 	Alias            = "alias"
@@ -1,9 +1,25 @@
 package attr
 
 const (
-	APIToken     = "api_token"
-	Network      = "network"
-	URL          = "url"
-	HTTPTimeout  = "http_timeout"
-	HTTPMaxRetry = "http_max_retry"
+	APIToken           = "api_token"
+	Network            = "network"
+	URL                = "url"
+	HTTPTimeout        = "http_timeout"
+	HTTPMaxRetry       = "http_max_retry"
+	HTTPRateLimit      = "http_rate_limit"
+	HTTPRateLimitBurst = "http_rate_limit_burst"
+	CACertPEM          = "ca_cert_pem"
+	CACertFile         = "ca_cert_file"
+	ClientCert         = "client_cert"
+	ClientKey          = "client_key"
+	ProxyURL           = "proxy_url"
+	NoProxy            = "no_proxy"
+	InsecureSkipVerify = "insecure_skip_verify"
+)
+
+const (
+	APITokenFile        = "api_token_file"
+	APITokenCommand     = "api_token_command"
+	APITokenCommandTTL  = "api_token_command_ttl"
+	APITokenAgentSocket = "api_token_agent_socket"
 )
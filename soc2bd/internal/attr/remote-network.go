@@ -1,6 +1,7 @@
 package attr
 
 const (
-	Location       = "location"
-	RemoteNetworks = "remote_networks"
+	Location          = "location"
+	RemoteNetworks    = "remote_networks"
+	HealthyConnectors = "healthy_connectors"
 )
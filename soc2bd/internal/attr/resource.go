@@ -8,6 +8,7 @@ const (
 	Policy                   = "policy"
 	Ports                    = "ports"
 	Address                  = "address"
+	AddressType              = "address_type"
 	Protocols                = "protocols"
 	AllowIcmp                = "allow_icmp"
 	TCP                      = "tcp"
@@ -15,4 +16,15 @@ const (
 	IsVisible                = "is_visible"
 	IsBrowserShortcutEnabled = "is_browser_shortcut_enabled"
 	Resources                = "resources"
+	ResourceID               = "resource_id"
+	PrincipalID              = "principal_id"
+	NamePrefix               = "name_prefix"
+	NameSuffix               = "name_suffix"
+	NameRegexp               = "name_regexp"
+	AddressContains          = "address_contains"
+	AddressWithinCIDR        = "address_within_cidr"
+	ProtocolPolicy           = "protocol_policy"
+	Port                     = "port"
+	Protocol                 = "protocol"
+	DeactivateOnDestroy      = "deactivate_on_destroy"
 )
@@ -1,5 +1,13 @@
 package attr
 
 const (
-	SecurityPolicies = "security_policies"
+	SecurityPolicies        = "security_policies"
+	RequireMFA              = "require_mfa"
+	MFAMethods              = "mfa_methods"
+	SessionLifetimeHours    = "session_lifetime_hours"
+	DevicePosture           = "device_posture"
+	RequireScreenLock       = "require_screen_lock"
+	RequireDiskEncryption   = "require_disk_encryption"
+	RequireFirewall         = "require_firewall"
+	AllowedOperatingSystems = "allowed_operating_systems"
 )
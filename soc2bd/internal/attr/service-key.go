@@ -3,4 +3,7 @@ package attr
 const (
 	ServiceAccountID = "service_account_id"
 	Token            = "token"
+	ExpirationTime   = "expiration_time"
+	ExpiresAt        = "expires_at"
+	Status           = "status"
 )
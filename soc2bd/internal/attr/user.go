@@ -1,12 +1,14 @@
 package attr
 
 const (
-	FirstName  = "first_name"
-	LastName   = "last_name"
-	Email      = "email"
-	IsAdmin    = "is_admin"
-	Role       = "role"
-	Users      = "users"
-	SendInvite = "send_invite"
-	State      = "state"
+	FirstName   = "first_name"
+	LastName    = "last_name"
+	Email       = "email"
+	IsAdmin     = "is_admin"
+	Role        = "role"
+	Users       = "users"
+	SendInvite  = "send_invite"
+	State       = "state"
+	EmailRegexp = "email_regexp"
+	EmailDomain = "email_domain"
 )
@@ -1,6 +0,0 @@
-package model
-
-type ConnectorTokens struct {
-	AccessToken  string
-	RefreshToken string
-}
@@ -0,0 +1,97 @@
+package datasource
+
+import (
+	"context"
+	"time"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func AccessReport() *schema.Resource {
+	return &schema.Resource{
+		Description: "Access report listing every User with the Groups they are in, the Resources each Group grants with the allowed ports and protocols, " +
+			"and the Security Policy applied, followed by the Resources granted to Service Accounts. Inactive Groups and Resources grant no access and are left out. The rows are sorted, so the content and its hash only change when the access does, " +
+			"which makes the report suitable as audit evidence.",
+		ReadContext: datasourceAccessReportRead,
+		Schema: map[string]*schema.Schema{
+			attr.ID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content hash of the report",
+			},
+			attr.GeneratedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the report was generated, in RFC 3339 format",
+			},
+			attr.ContentHash: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The hex encoded SHA-256 hash of the CSV content",
+			},
+			attr.RowCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of rows in the report",
+			},
+			attr.CSV: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The report rows in CSV format with a header line",
+			},
+			attr.JSON: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The report in JSON format, with the `generated_at` timestamp, the `content_hash` and the `rows`",
+			},
+		},
+	}
+}
+
+func datasourceAccessReportRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*provider.Meta).Client
+
+	inventory, err := c.ReadAccessInventory(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	report := model.NewAccessReport(inventory, time.Now())
+
+	content, err := report.CSV()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hash, err := report.ContentHash()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	document, err := report.JSON()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attr.GeneratedAt: report.GeneratedAt.Format(time.RFC3339),
+		attr.ContentHash: hash,
+		attr.RowCount:    len(report.Rows),
+		attr.CSV:         content,
+		attr.JSON:        document,
+	}
+
+	for attribute, value := range values {
+		if err := resourceData.Set(attribute, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	resourceData.SetId(hash)
+
+	return nil
+}
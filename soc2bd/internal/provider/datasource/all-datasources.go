@@ -14,4 +14,6 @@ const (
 	Soc2bdServiceAccounts  = "soc2bd_service_accounts"
 	Soc2bdSecurityPolicy   = "soc2bd_security_policy"
 	Soc2bdSecurityPolicies = "soc2bd_security_policies"
+	Soc2bdAccessReport     = "soc2bd_access_report"
+	Soc2bdEffectiveAccess  = "soc2bd_effective_access"
 )
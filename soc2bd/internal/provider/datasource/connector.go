@@ -2,15 +2,18 @@ package datasource
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func datasourceConnectorRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 	connectorID := resourceData.Get(attr.ID).(string)
 
 	connector, err := c.ReadConnector(ctx, connectorID)
@@ -30,11 +33,40 @@ func datasourceConnectorRead(ctx context.Context, resourceData *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
+	if err := resourceData.Set(attr.State, connector.State); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := resourceData.Set(attr.LastHeartbeatAt, connector.LastHeartbeatAt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := resourceData.Set(attr.Version, connector.Version); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := resourceData.Set(attr.Hostname, connector.Hostname); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := resourceData.Set(attr.PublicIP, connector.PublicIP); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := resourceData.Set(attr.PrivateIPs, connector.PrivateIPs); err != nil {
+		return diag.FromErr(err)
+	}
+
 	resourceData.SetId(connectorID)
 
 	return nil
 }
 
+func connectorStateDescription() string {
+	return fmt.Sprintf("The state of the Connector, `%s` when it's online. Can be any of: %s.",
+		model.ConnectorStateAlive, strings.Join(model.ConnectorStates, ", "))
+}
+
 func Connector() *schema.Resource {
 	return &schema.Resource{
 		Description: "Connectors provide connectivity to Remote Networks. For more information, see Soc2bd's [documentation](https://docs.soc2bd.com/docs/understanding-access-nodes).",
@@ -61,6 +93,37 @@ func Connector() *schema.Resource {
 				Computed:    true,
 				Description: "Determines whether status notifications are enabled for the Connector.",
 			},
+			attr.State: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: connectorStateDescription(),
+			},
+			attr.LastHeartbeatAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of the last heartbeat received from the Connector, in RFC 3339 format.",
+			},
+			attr.Version: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the Connector.",
+			},
+			attr.Hostname: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The hostname of the machine running the Connector.",
+			},
+			attr.PublicIP: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The public IP address of the Connector.",
+			},
+			attr.PrivateIPs: {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The private IP addresses of the Connector.",
+			},
 		},
 	}
 }
@@ -5,13 +5,14 @@ import (
 	"errors"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func datasourceConnectorsRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 
 	connectors, err := c.ReadConnectors(ctx)
 	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
@@ -58,6 +59,37 @@ func Connectors() *schema.Resource {
 							Computed:    true,
 							Description: "Determines whether status notifications are enabled for the Connector.",
 						},
+						attr.State: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: connectorStateDescription(),
+						},
+						attr.LastHeartbeatAt: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time of the last heartbeat received from the Connector, in RFC 3339 format.",
+						},
+						attr.Version: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version of the Connector.",
+						},
+						attr.Hostname: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The hostname of the machine running the Connector.",
+						},
+						attr.PublicIP: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The public IP address of the Connector.",
+						},
+						attr.PrivateIPs: {
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Computed:    true,
+							Description: "The private IP addresses of the Connector.",
+						},
 					},
 				},
 			},
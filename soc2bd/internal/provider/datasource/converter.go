@@ -1,7 +1,7 @@
 package datasource
 
 import (
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 )
 
 func convertConnectorsToTerraform(connectors []*model.Connector) []interface{} {
@@ -5,7 +5,7 @@ import (
 	"testing"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -26,7 +26,11 @@ func TestConverterConnectorsToTerraform(t *testing.T) {
 		},
 		{
 			input: []*model.Connector{
-				{ID: "connector-id", Name: "connector-name", NetworkID: "network-id", StatusUpdatesEnabled: &boolTrue},
+				{
+					ID: "connector-id", Name: "connector-name", NetworkID: "network-id", StatusUpdatesEnabled: &boolTrue,
+					State: model.ConnectorStateAlive, LastHeartbeatAt: "2026-01-02T03:04:05Z", Version: "1.60.0",
+					Hostname: "connector-host", PublicIP: "203.0.113.10", PrivateIPs: []string{"10.0.0.10"},
+				},
 			},
 			expected: []interface{}{
 				map[string]interface{}{
@@ -34,6 +38,12 @@ func TestConverterConnectorsToTerraform(t *testing.T) {
 					attr.Name:                 "connector-name",
 					attr.RemoteNetworkID:      "network-id",
 					attr.StatusUpdatesEnabled: true,
+					attr.State:                model.ConnectorStateAlive,
+					attr.LastHeartbeatAt:      "2026-01-02T03:04:05Z",
+					attr.Version:              "1.60.0",
+					attr.Hostname:             "connector-host",
+					attr.PublicIP:             "203.0.113.10",
+					attr.PrivateIPs:           []string{"10.0.0.10"},
 				},
 			},
 		},
@@ -62,7 +72,7 @@ func TestConverterGroupsToTerraform(t *testing.T) {
 		},
 		{
 			input: []*model.Group{
-				{ID: "group-id", Name: "group-name", Type: model.GroupTypeManual, IsActive: true, SecurityPolicyID: "policy-id"},
+				{ID: "group-id", Name: "group-name", Type: model.GroupTypeManual, IsActive: true, SecurityPolicyID: "policy-id", Users: []string{"user-id"}, Resources: []string{"resource-id"}},
 			},
 			expected: []interface{}{
 				map[string]interface{}{
@@ -71,6 +81,8 @@ func TestConverterGroupsToTerraform(t *testing.T) {
 					attr.Type:             model.GroupTypeManual,
 					attr.IsActive:         true,
 					attr.SecurityPolicyID: "policy-id",
+					attr.UserIDs:          []string{"user-id"},
+					attr.ResourceIDs:      []string{"resource-id"},
 				},
 			},
 		},
@@ -111,6 +123,7 @@ func TestConverterUsersToTerraform(t *testing.T) {
 					attr.IsAdmin:   false,
 					attr.Role:      "USER",
 					attr.Type:      "SYNCED",
+					attr.GroupIDs:  []string(nil),
 				},
 				map[string]interface{}{
 					attr.ID:        "admin-id",
@@ -120,6 +133,7 @@ func TestConverterUsersToTerraform(t *testing.T) {
 					attr.IsAdmin:   true,
 					attr.Role:      model.UserRoleAdmin,
 					attr.Type:      "MANUAL",
+					attr.GroupIDs:  []string(nil),
 				},
 			},
 		},
@@ -0,0 +1,134 @@
+package datasource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var ErrPortWithICMP = errors.New("port can't be set when protocol is ICMP")
+
+func EffectiveAccess() *schema.Resource {
+	return &schema.Resource{
+		Description: "Evaluates who can reach an address: the active Resources whose address and protocols match, " +
+			"and the Users, Groups and Service Accounts granted access to them.",
+		ReadContext: datasourceEffectiveAccessRead,
+		Schema: map[string]*schema.Schema{
+			attr.Address: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description: "The IP, CIDR or hostname to evaluate. It's matched against IP and CIDR Resources, " +
+					"or against FQDN and wildcard DNS zone Resources such as `*.example.com`.",
+			},
+			attr.Port: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
+				Description:  "The port to evaluate, any allowed port matches when not set",
+			},
+			attr.Protocol: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(model.AccessProtocols, false),
+				Description:  fmt.Sprintf("The protocol to evaluate, TCP or UDP match when not set. Can be any of: %s.", strings.Join(model.AccessProtocols, ", ")),
+			},
+			// computed
+			attr.Resources: resourcesListSchema(),
+			attr.ResourceIDs: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the matching Resources",
+			},
+			attr.GroupIDs: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the active Groups with access to the matching Resources",
+			},
+			attr.UserIDs: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the Users in those Groups",
+			},
+			attr.ServiceAccountIDs: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the Service Accounts with access to the matching Resources",
+			},
+		},
+	}
+}
+
+func datasourceEffectiveAccessRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*provider.Meta).Client
+
+	query := model.EffectiveAccessQuery{
+		Address:  resourceData.Get(attr.Address).(string),
+		Port:     resourceData.Get(attr.Port).(int),
+		Protocol: resourceData.Get(attr.Protocol).(string),
+	}
+
+	if query.Protocol == model.ProtocolICMP && query.Port != 0 {
+		return diag.FromErr(ErrPortWithICMP)
+	}
+
+	inventory, err := c.ReadAccessInventory(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	access := inventory.EffectiveAccess(query)
+
+	values := map[string]interface{}{
+		attr.Resources:         convertResourcesToTerraform(access.Resources),
+		attr.ResourceIDs:       convertResourceIDs(access.Resources),
+		attr.GroupIDs:          access.Groups,
+		attr.UserIDs:           access.Users,
+		attr.ServiceAccountIDs: access.ServiceAccounts,
+	}
+
+	for attribute, value := range values {
+		if err := resourceData.Set(attribute, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	resourceData.SetId(effectiveAccessID(query))
+
+	return nil
+}
+
+func convertResourceIDs(resources []*model.Resource) []string {
+	ids := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		ids = append(ids, resource.ID)
+	}
+
+	return ids
+}
+
+func effectiveAccessID(query model.EffectiveAccessQuery) string {
+	id := "effective-access-" + query.Address
+
+	if query.Protocol != "" {
+		id += "-" + query.Protocol
+	}
+
+	if query.Port != 0 {
+		id += fmt.Sprintf("-%d", query.Port)
+	}
+
+	return id
+}
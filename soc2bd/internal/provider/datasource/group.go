@@ -4,13 +4,13 @@ import (
 	"context"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func datasourceGroupRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 	groupID := resourceData.Get(attr.ID).(string)
 
 	group, err := c.ReadGroup(ctx, groupID)
@@ -34,6 +34,14 @@ func datasourceGroupRead(ctx context.Context, resourceData *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	if err := resourceData.Set(attr.UserIDs, group.Users); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := resourceData.Set(attr.ResourceIDs, group.Resources); err != nil {
+		return diag.FromErr(err)
+	}
+
 	resourceData.SetId(groupID)
 
 	return nil
@@ -69,6 +77,18 @@ func Group() *schema.Resource {
 				Computed:    true,
 				Description: "The Security Policy assigned to the Group.",
 			},
+			attr.UserIDs: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the Users in the Group",
+			},
+			attr.ResourceIDs: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the Resources the Group has access to",
+			},
 		},
 	}
 }
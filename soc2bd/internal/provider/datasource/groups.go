@@ -6,15 +6,16 @@ import (
 	"fmt"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func datasourceGroupsRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 	filter := buildFilter(resourceData)
 
 	groups, err := c.ReadGroups(ctx, filter)
@@ -88,6 +89,18 @@ func Groups() *schema.Resource {
 							Computed:    true,
 							Description: "The Security Policy assigned to the Group.",
 						},
+						attr.UserIDs: {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IDs of the Users in the Group",
+						},
+						attr.ResourceIDs: {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IDs of the Resources the Group has access to",
+						},
 					},
 				},
 			},
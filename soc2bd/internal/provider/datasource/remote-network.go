@@ -6,14 +6,14 @@ import (
 	"strings"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func datasourceRemoteNetworkRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 	networkID := resourceData.Get(attr.ID).(string)
 	networkName := resourceData.Get(attr.Name).(string)
 
@@ -26,6 +26,15 @@ func datasourceRemoteNetworkRead(ctx context.Context, resourceData *schema.Resou
 		return diag.FromErr(err)
 	}
 
+	connectors, err := c.ReadRemoteNetworkConnectors(ctx, network.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := resourceData.Set(attr.HealthyConnectors, model.CountAliveConnectors(connectors)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	resourceData.SetId(network.ID)
 
 	return nil
@@ -53,6 +62,11 @@ func RemoteNetwork() *schema.Resource {
 				Computed:    true,
 				Description: fmt.Sprintf("The location of the Remote Network. Must be one of the following: %s.", strings.Join(model.Locations, ", ")),
 			},
+			attr.HealthyConnectors: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of Connectors in the Remote Network that are online.",
+			},
 		},
 	}
 }
@@ -4,19 +4,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+//nolint:gochecknoglobals
+var resourcesFilters = []string{
+	attr.Name, attr.NamePrefix, attr.NameSuffix, attr.NameRegexp, attr.RemoteNetworkID,
+	attr.AddressContains, attr.AddressWithinCIDR, attr.ProtocolPolicy, attr.GroupID, attr.ServiceAccountID,
+}
+
 func datasourceResourcesRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
-	resourceName := resourceData.Get(attr.Name).(string)
+	c := meta.(*provider.Meta).Client
 
-	resources, err := c.ReadResourcesByName(ctx, resourceName)
+	resources, err := c.ReadResourcesByFilter(ctx, buildResourcesFilter(resourceData))
 	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
 		return diag.FromErr(err)
 	}
@@ -25,12 +33,130 @@ func datasourceResourcesRead(ctx context.Context, resourceData *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	resourceData.SetId("query resources by name: " + resourceName)
+	resourceData.SetId(resourcesFilterID(resourceData))
 
 	return nil
 }
 
+func buildResourcesFilter(resourceData *schema.ResourceData) *model.ResourcesFilter {
+	get := func(attribute string) *string {
+		val, ok := resourceData.GetOk(attribute)
+		if !ok {
+			return nil
+		}
+
+		str := val.(string)
+
+		return &str
+	}
+
+	filter := &model.ResourcesFilter{
+		Name:              get(attr.Name),
+		NamePrefix:        get(attr.NamePrefix),
+		NameSuffix:        get(attr.NameSuffix),
+		NameRegexp:        get(attr.NameRegexp),
+		RemoteNetworkID:   get(attr.RemoteNetworkID),
+		AddressContains:   get(attr.AddressContains),
+		AddressWithinCIDR: get(attr.AddressWithinCIDR),
+		ProtocolPolicy:    get(attr.ProtocolPolicy),
+		GroupID:           get(attr.GroupID),
+		ServiceAccountID:  get(attr.ServiceAccountID),
+	}
+
+	if *filter == (model.ResourcesFilter{}) {
+		return nil
+	}
+
+	return filter
+}
+
+func resourcesFilterID(resourceData *schema.ResourceData) string {
+	filters := make([]string, 0, len(resourcesFilters))
+
+	for _, filter := range resourcesFilters {
+		if val, ok := resourceData.GetOk(filter); ok {
+			filters = append(filters, fmt.Sprintf("%s=%s", filter, val))
+		}
+	}
+
+	name, hasName := resourceData.GetOk(attr.Name)
+
+	switch {
+	case len(filters) == 0:
+		return "all-resources"
+	case hasName && len(filters) == 1:
+		return "query resources by name: " + name.(string)
+	default:
+		return "query resources by filter: " + strings.Join(filters, ", ")
+	}
+}
+
 func Resources() *schema.Resource { //nolint:funlen
+	return &schema.Resource{
+		Description: "Resources in Soc2bd represent servers on the private network that clients can connect to. Resources can be defined by IP, CIDR range, FQDN, or DNS zone. For more information, see the Soc2bd [documentation](https://docs.soc2bd.com/docs/resources-and-access-nodes).",
+		ReadContext: datasourceResourcesRead,
+		Schema: map[string]*schema.Schema{
+			attr.Name: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns only Resources that exactly match this name. All Resources are returned when no filter is set, all the filters set must match.",
+			},
+			attr.NamePrefix: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns only Resources with a name starting with this prefix.",
+			},
+			attr.NameSuffix: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns only Resources with a name ending with this suffix.",
+			},
+			attr.NameRegexp: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Returns only Resources with a name matching this regular expression.",
+			},
+			attr.RemoteNetworkID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns only Resources in this Remote Network.",
+			},
+			attr.AddressContains: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns only Resources with an address containing this string.",
+			},
+			attr.AddressWithinCIDR: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsCIDR,
+				Description:  "Returns only Resources with an IP or CIDR address inside this CIDR block. Resources with an FQDN address don't match.",
+			},
+			attr.ProtocolPolicy: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(model.Policies, false),
+				Description:  fmt.Sprintf("Returns only Resources with this TCP or UDP policy. Can be `%s`, `%s`, or `%s`.", model.PolicyRestricted, model.PolicyAllowAll, model.PolicyDenyAll),
+			},
+			attr.GroupID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns only Resources this Group has access to.",
+			},
+			attr.ServiceAccountID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns only Resources this Service Account has access to.",
+			},
+			// computed
+			attr.Resources: resourcesListSchema(),
+		},
+	}
+}
+
+// resourcesListSchema is the computed list of Resources shared by the data sources returning Resources.
+func resourcesListSchema() *schema.Schema {
 	portsResource := schema.Resource{
 		Schema: map[string]*schema.Schema{
 			attr.Policy: {
@@ -49,64 +175,52 @@ func Resources() *schema.Resource { //nolint:funlen
 		},
 	}
 
-	return &schema.Resource{
-		Description: "Resources in Soc2bd represent servers on the private network that clients can connect to. Resources can be defined by IP, CIDR range, FQDN, or DNS zone. For more information, see the Soc2bd [documentation](https://docs.soc2bd.com/docs/resources-and-access-nodes).",
-		ReadContext: datasourceResourcesRead,
-		Schema: map[string]*schema.Schema{
-			attr.Name: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the Resource",
-			},
-			// computed
-			attr.Resources: {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "List of Resources",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						attr.ID: {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "The id of the Resource",
-						},
-						attr.Name: {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "The name of the Resource",
-						},
-						attr.Address: {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "The Resource's IP/CIDR or FQDN/DNS zone",
-						},
-						attr.RemoteNetworkID: {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "Remote Network ID where the Resource lives",
-						},
-						attr.Protocols: {
-							Type:        schema.TypeList,
-							Optional:    true,
-							Description: "Restrict access to certain protocols and ports. By default or when this argument is not defined, there is no restriction, and all protocols and ports are allowed.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									attr.AllowIcmp: {
-										Type:        schema.TypeBool,
-										Computed:    true,
-										Description: "Whether to allow ICMP (ping) traffic",
-									},
-									attr.TCP: {
-										Type:     schema.TypeList,
-										Optional: true,
-										Elem:     &portsResource,
-									},
-									attr.UDP: {
-										Type:     schema.TypeList,
-										Optional: true,
-										Elem:     &portsResource,
-									},
-								},
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "List of Resources",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				attr.ID: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The id of the Resource",
+				},
+				attr.Name: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the Resource",
+				},
+				attr.Address: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Resource's IP/CIDR or FQDN/DNS zone",
+				},
+				attr.RemoteNetworkID: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Remote Network ID where the Resource lives",
+				},
+				attr.Protocols: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Restrict access to certain protocols and ports. By default or when this argument is not defined, there is no restriction, and all protocols and ports are allowed.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							attr.AllowIcmp: {
+								Type:        schema.TypeBool,
+								Computed:    true,
+								Description: "Whether to allow ICMP (ping) traffic",
+							},
+							attr.TCP: {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &portsResource,
+							},
+							attr.UDP: {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &portsResource,
 							},
 						},
 					},
@@ -4,7 +4,7 @@ import (
 	"context"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -31,7 +31,7 @@ func SecurityPolicy() *schema.Resource {
 }
 
 func readSecurityPolicy(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*client.Client)
+	client := meta.(*provider.Meta).Client
 
 	securityPolicy, err := client.ReadSecurityPolicy(ctx, resourceData.Get(attr.ID).(string), resourceData.Get(attr.Name).(string))
 	if err != nil {
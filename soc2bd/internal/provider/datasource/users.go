@@ -2,18 +2,29 @@ package datasource
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+//nolint:gochecknoglobals
+var usersFilters = []string{
+	attr.Role, attr.Type, attr.State, attr.EmailRegexp, attr.EmailDomain, attr.FirstName, attr.LastName,
+}
+
 func datasourceUsersRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 
-	users, err := c.ReadUsers(ctx)
-	if err != nil {
+	users, err := c.ReadUsersByFilter(ctx, buildUsersFilter(resourceData))
+	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
 		return diag.FromErr(err)
 	}
 
@@ -23,16 +34,103 @@ func datasourceUsersRead(ctx context.Context, resourceData *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	resourceData.SetId("users-all")
+	resourceData.SetId(usersFilterID(resourceData))
 
 	return nil
 }
 
-func Users() *schema.Resource {
+func buildUsersFilter(resourceData *schema.ResourceData) *model.UsersFilter {
+	get := func(attribute string) *string {
+		val, ok := resourceData.GetOk(attribute)
+		if !ok {
+			return nil
+		}
+
+		str := val.(string)
+
+		return &str
+	}
+
+	filter := &model.UsersFilter{
+		Role:        get(attr.Role),
+		Type:        get(attr.Type),
+		State:       get(attr.State),
+		EmailRegexp: get(attr.EmailRegexp),
+		EmailDomain: get(attr.EmailDomain),
+		FirstName:   get(attr.FirstName),
+		LastName:    get(attr.LastName),
+	}
+
+	if *filter == (model.UsersFilter{}) {
+		return nil
+	}
+
+	return filter
+}
+
+func usersFilterID(resourceData *schema.ResourceData) string {
+	filters := make([]string, 0, len(usersFilters))
+
+	for _, filter := range usersFilters {
+		if val, ok := resourceData.GetOk(filter); ok {
+			filters = append(filters, fmt.Sprintf("%s=%s", filter, val))
+		}
+	}
+
+	if len(filters) == 0 {
+		return "users-all"
+	}
+
+	return "query users by filter: " + strings.Join(filters, ", ")
+}
+
+func Users() *schema.Resource { //nolint:funlen
 	return &schema.Resource{
 		Description: userDescription,
 		ReadContext: datasourceUsersRead,
 		Schema: map[string]*schema.Schema{
+			// optional
+			attr.Role: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(model.UserRoles, false),
+				Description:  fmt.Sprintf("Returns only Users with the role. Can be any of: %s.", strings.Join(model.UserRoles, ", ")),
+			},
+			attr.Type: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(model.UserTypes, false),
+				Description:  fmt.Sprintf("Returns only Users of the type. Can be any of: %s.", strings.Join(model.UserTypes, ", ")),
+			},
+			attr.State: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(model.UserStates, false),
+				Description:  fmt.Sprintf("Returns only Users in the state. Can be any of: %s.", strings.Join(model.UserStates, ", ")),
+			},
+			attr.EmailRegexp: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Returns only Users whose email address matches the regular expression",
+			},
+			attr.EmailDomain: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringDoesNotContainAny("@"),
+				Description:  "Returns only Users whose email address is in the domain, e.g. `example.com`",
+			},
+			attr.FirstName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns only Users with the exact first name",
+			},
+			attr.LastName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns only Users with the exact last name",
+			},
+			// computed
 			attr.Users: {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -74,6 +172,12 @@ func Users() *schema.Resource {
 							Computed:    true,
 							Description: "Indicates the User's type. Either MANUAL or SYNCED.",
 						},
+						attr.GroupIDs: {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IDs of the Groups the User belongs to",
+						},
 					},
 				},
 			},
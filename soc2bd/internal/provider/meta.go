@@ -0,0 +1,12 @@
+package provider
+
+import "github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+
+// Meta is built once per provider configuration and passed to the resources and data sources
+// of both the SDK and the plugin framework providers.
+type Meta struct {
+	Client *client.Client
+	// DeletionProtection is the default deletion protection level of the managed objects,
+	// one of model.DeletionProtectionLevels.
+	DeletionProtection string
+}
@@ -5,7 +5,10 @@ const (
 	Soc2bdConnector         = "soc2bd_connector"
 	Soc2bdConnectorTokens   = "soc2bd_connector_tokens"
 	Soc2bdGroup             = "soc2bd_group"
+	Soc2bdGroupMembership   = "soc2bd_group_membership"
 	Soc2bdResource          = "soc2bd_resource"
+	Soc2bdResourceAccess    = "soc2bd_resource_access"
+	Soc2bdSecurityPolicy    = "soc2bd_security_policy"
 	Soc2bdServiceAccount    = "soc2bd_service_account"
 	Soc2bdServiceAccountKey = "soc2bd_service_account_key"
 	Soc2bdUser              = "soc2bd_user"
@@ -3,20 +3,30 @@ package resource
 import (
 	"context"
 	"fmt"
-	"log"
+	"time"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const hoursInDay = 24
+
+var ErrInvalidRotateBefore = fmt.Errorf("%s must be less than %s", attr.RotateBefore, attr.RotationDays)
+
 func ConnectorTokens() *schema.Resource {
-	return &schema.Resource{
+	return withLogging(Soc2bdConnectorTokens, &schema.Resource{
 		Description:   "This resource type will generate tokens for a Connector, which are needed to successfully provision one on your network. The Connector itself has its own resource type and must be created before you can provision tokens.",
 		CreateContext: resourceConnectorTokensCreate,
 		ReadContext:   resourceConnectorTokensRead,
+		UpdateContext: resourceConnectorTokensUpdate,
 		DeleteContext: resourceConnectorTokensDelete,
+		CustomizeDiff: resourceConnectorTokensCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			// required
@@ -33,6 +43,19 @@ func ConnectorTokens() *schema.Resource {
 				Optional:    true,
 				ForceNew:    true,
 			},
+			attr.RotationDays: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Specifies after how many days the tokens are rotated: once they are older, the plan replaces them. By default the tokens are not rotated.",
+			},
+			attr.RotateBefore: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  fmt.Sprintf("Specifies how many days before `%s` the tokens are rotated, so they are replaced ahead of a scheduled apply. Must be less than `%s`.", attr.ExpiresAt, attr.RotationDays),
+			},
+			attr.DeletionProtection: connectorTokensDeletionProtectionSchema(),
 			// Computed
 			attr.AccessToken: {
 				Type:        schema.TypeString,
@@ -46,19 +69,43 @@ func ConnectorTokens() *schema.Resource {
 				Sensitive:   true,
 				Description: "The Refresh Token of the parent Connector",
 			},
+			attr.IssuedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the tokens were issued at, in RFC3339 format.",
+			},
+			attr.ExpiresAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("The time the tokens are due for rotation at, in RFC3339 format. Empty when `%s` is not set.", attr.RotationDays),
+			},
+			attr.Status: {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: fmt.Sprintf("The status of the tokens: %s, %s when the tokens were invalidated in Soc2bd, or %s when they are old enough to be rotated. Tokens that are not valid are replaced on the next apply.",
+					model.ConnectorTokensStatusValid, model.ConnectorTokensStatusInvalidated, model.ConnectorTokensStatusRotationDue),
+			},
 		},
-	}
+	})
+}
+
+// connectorTokensDeletionProtectionSchema notes that protected tokens aren't rotated either, a rotation replaces them.
+func connectorTokensDeletionProtectionSchema() *schema.Schema {
+	protection := deletionProtectionSchema("Connector Tokens")
+	protection.Description += " Rotating the tokens replaces them, so protected tokens can't be rotated either."
+
+	return protection
 }
 
 func resourceConnectorTokensCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 
 	connectorID := resourceData.Get(attr.ConnectorID).(string)
 	resourceData.SetId(connectorID)
 
 	tokens, err := c.GenerateConnectorTokens(ctx, connectorID)
 	if err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
 	if err := resourceData.Set(attr.AccessToken, tokens.AccessToken); err != nil {
@@ -69,42 +116,167 @@ func resourceConnectorTokensCreate(ctx context.Context, resourceData *schema.Res
 		return ErrAttributeSet(err, attr.RefreshToken)
 	}
 
+	if err := resourceData.Set(attr.IssuedAt, time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return ErrAttributeSet(err, attr.IssuedAt)
+	}
+
+	return resourceConnectorTokensRead(ctx, resourceData, meta)
+}
+
+func resourceConnectorTokensUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// only the rotation settings can be updated in place, expires_at is recalculated on read
 	return resourceConnectorTokensRead(ctx, resourceData, meta)
 }
 
 func resourceConnectorTokensDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
+
+	if diags := checkDeletionProtection(meta, Soc2bdConnectorTokens, resourceData); diags.HasError() {
+		return diags
+	}
 
 	// Just calling generate new tokens for the connector so the old ones are invalidated
 	_, err := c.GenerateConnectorTokens(ctx, resourceData.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Invalidating Connector Tokens id %s", resourceData.Id())
+	tflog.SubsystemInfo(ctx, Soc2bdConnectorTokens, "Invalidated connector tokens")
 	resourceData.SetId("")
 
 	return nil
 }
 
 func resourceConnectorTokensRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 	accessToken := resourceData.Get(attr.AccessToken).(string)
 	refreshToken := resourceData.Get(attr.RefreshToken).(string)
+	ctx = client.MaskLogValues(ctx, Soc2bdConnectorTokens, accessToken, refreshToken)
 
-	err := c.VerifyConnectorTokens(ctx, refreshToken, accessToken)
-	if err != nil {
-		resourceData.SetId("")
+	status := model.ConnectorTokensStatusValid
 
-		return diag.Diagnostics{
-			{
-				Severity: diag.Warning,
-				Summary:  "can't to verify connector tokens",
-				Detail:   fmt.Sprintf("can't verify connector %s tokens, assuming not valid and needs to be recreated", resourceData.Id()),
-			},
+	if err := c.VerifyConnectorTokens(ctx, refreshToken, accessToken); err != nil {
+		if !isConnectorTokensInvalid(err) {
+			return ErrDiagnostics(err)
+		}
+
+		tflog.SubsystemWarn(ctx, Soc2bdConnectorTokens, "Connector tokens were invalidated in Soc2bd", map[string]interface{}{"error": err.Error()})
+
+		status = model.ConnectorTokensStatusInvalidated
+	}
+
+	issuedAt := resourceData.Get(attr.IssuedAt).(string)
+	if issuedAt == "" {
+		// tokens created before issued_at was recorded start their rotation period now
+		issuedAt = time.Now().UTC().Format(time.RFC3339)
+
+		if err := resourceData.Set(attr.IssuedAt, issuedAt); err != nil {
+			return ErrAttributeSet(err, attr.IssuedAt)
 		}
 	}
 
+	rotationDays := resourceData.Get(attr.RotationDays).(int)
+
+	expiresAt, err := connectorTokensExpiresAt(issuedAt, rotationDays)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := resourceData.Set(attr.ExpiresAt, formatTime(expiresAt)); err != nil {
+		return ErrAttributeSet(err, attr.ExpiresAt)
+	}
+
+	if status == model.ConnectorTokensStatusValid && connectorTokensRotationDue(expiresAt, resourceData.Get(attr.RotateBefore).(int), time.Now()) {
+		status = model.ConnectorTokensStatusRotationDue
+	}
+
+	if err := resourceData.Set(attr.Status, status); err != nil {
+		return ErrAttributeSet(err, attr.Status)
+	}
+
 	return nil
 }
+
+// resourceConnectorTokensCustomizeDiff replaces the tokens that were invalidated or are due for rotation,
+// the status in the plan tells the reason.
+func resourceConnectorTokensCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	rotationDays := diff.Get(attr.RotationDays).(int)
+	rotateBefore := diff.Get(attr.RotateBefore).(int)
+
+	if rotationDays > 0 && rotateBefore >= rotationDays {
+		return ErrInvalidRotateBefore
+	}
+
+	if diff.Id() == "" {
+		return nil
+	}
+
+	expiresAt, err := connectorTokensExpiresAt(diff.Get(attr.IssuedAt).(string), rotationDays)
+	if err != nil {
+		return err
+	}
+
+	status := diff.Get(attr.Status).(string)
+	if status == model.ConnectorTokensStatusValid && connectorTokensRotationDue(expiresAt, rotateBefore, time.Now()) {
+		status = model.ConnectorTokensStatusRotationDue
+	}
+
+	if status == "" || status == model.ConnectorTokensStatusValid {
+		if diff.HasChange(attr.RotationDays) {
+			return diff.SetNew(attr.ExpiresAt, formatTime(expiresAt)) //nolint:wrapcheck
+		}
+
+		return nil
+	}
+
+	tflog.SubsystemInfo(ctx, Soc2bdConnectorTokens, "Connector tokens will be replaced", map[string]interface{}{attr.Status: status})
+
+	for _, key := range []string{attr.IssuedAt, attr.ExpiresAt, attr.Status} {
+		if err := diff.SetNewComputed(key); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+
+	return diff.ForceNew(attr.Status) //nolint:wrapcheck
+}
+
+// isConnectorTokensInvalid tells apart the tokens rejected by the API from failures to verify them.
+func isConnectorTokensInvalid(err error) bool {
+	switch client.ErrorKindOf(err) {
+	case client.ErrorKindPermissionDenied, client.ErrorKindNotFound, client.ErrorKindValidation:
+		return true
+	default:
+		return false
+	}
+}
+
+// connectorTokensExpiresAt returns the time the tokens are due for rotation, zero when rotation is not set.
+func connectorTokensExpiresAt(issuedAt string, rotationDays int) (time.Time, error) {
+	if issuedAt == "" || rotationDays <= 0 {
+		return time.Time{}, nil
+	}
+
+	issued, err := time.Parse(time.RFC3339, issuedAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse %s `%s`: %w", attr.IssuedAt, issuedAt, err)
+	}
+
+	return issued.Add(time.Duration(rotationDays) * hoursInDay * time.Hour), nil
+}
+
+func connectorTokensRotationDue(expiresAt time.Time, rotateBefore int, now time.Time) bool {
+	if expiresAt.IsZero() {
+		return false
+	}
+
+	return !now.Before(expiresAt.Add(-time.Duration(rotateBefore) * hoursInDay * time.Hour))
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,121 @@
+package resource
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectorTokensRotationDue(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	expiresAt, err := connectorTokensExpiresAt("2023-05-01T12:00:00Z", 30)
+	require.NoError(t, err)
+	assert.Equal(t, "2023-05-31T12:00:00Z", formatTime(expiresAt))
+
+	assert.True(t, connectorTokensRotationDue(expiresAt, 0, now))
+	assert.False(t, connectorTokensRotationDue(expiresAt, 0, now.AddDate(0, 0, -2)))
+	assert.True(t, connectorTokensRotationDue(expiresAt, 3, now.AddDate(0, 0, -2)))
+
+	expiresAt, err = connectorTokensExpiresAt("2023-05-01T12:00:00Z", 0)
+	require.NoError(t, err)
+	assert.True(t, expiresAt.IsZero())
+	assert.False(t, connectorTokensRotationDue(expiresAt, 0, now))
+
+	_, err = connectorTokensExpiresAt("yesterday", 30)
+	assert.Error(t, err)
+}
+
+func TestConnectorTokensDiff(t *testing.T) {
+	now := time.Now().UTC()
+
+	cases := []struct {
+		name         string
+		status       string
+		issuedAt     time.Time
+		rotationDays int
+		requiresNew  bool
+	}{
+		{name: "valid", status: model.ConnectorTokensStatusValid, issuedAt: now, requiresNew: false},
+		{name: "invalidated", status: model.ConnectorTokensStatusInvalidated, issuedAt: now, requiresNew: true},
+		{name: "rotation due", status: model.ConnectorTokensStatusRotationDue, issuedAt: now, requiresNew: true},
+		{name: "not old enough", status: model.ConnectorTokensStatusValid, issuedAt: now.AddDate(0, 0, -10), rotationDays: 30, requiresNew: false},
+		{name: "old enough", status: model.ConnectorTokensStatusValid, issuedAt: now.AddDate(0, 0, -31), rotationDays: 30, requiresNew: true},
+	}
+
+	for _, c := range cases {
+		t.Run("Test Soc2bd Resource : Connector Tokens Diff "+c.name, func(t *testing.T) {
+			expiresAt, err := connectorTokensExpiresAt(formatTime(c.issuedAt), c.rotationDays)
+			require.NoError(t, err)
+
+			attributes := map[string]string{
+				attr.ID:           "connector-id",
+				attr.ConnectorID:  "connector-id",
+				attr.RotateBefore: "0",
+				attr.IssuedAt:     formatTime(c.issuedAt),
+				attr.ExpiresAt:    formatTime(expiresAt),
+				attr.Status:       c.status,
+			}
+			rawConfig := map[string]interface{}{
+				attr.ConnectorID: "connector-id",
+			}
+
+			if c.rotationDays > 0 {
+				attributes[attr.RotationDays] = strconv.Itoa(c.rotationDays)
+				rawConfig[attr.RotationDays] = c.rotationDays
+			}
+
+			state := &terraform.InstanceState{ID: "connector-id", Attributes: attributes}
+
+			diff, err := ConnectorTokens().SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(rawConfig), nil)
+			require.NoError(t, err)
+
+			if !c.requiresNew {
+				assert.True(t, diff == nil || diff.Empty())
+
+				return
+			}
+
+			require.NotNil(t, diff)
+			assert.True(t, diff.RequiresNew())
+		})
+	}
+}
+
+func TestConnectorTokensDiffInvalidRotateBefore(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		attr.ConnectorID:  "connector-id",
+		attr.RotationDays: 7,
+		attr.RotateBefore: 7,
+	})
+
+	_, err := ConnectorTokens().SimpleDiff(context.Background(), &terraform.InstanceState{}, config, nil)
+	assert.ErrorIs(t, err, ErrInvalidRotateBefore)
+}
+
+func TestConnectorTokensDiffWithoutRotateBefore(t *testing.T) {
+	// state written before rotate_before was added, an unset value means 0
+	state := &terraform.InstanceState{
+		ID: "connector-id",
+		Attributes: map[string]string{
+			attr.ID:          "connector-id",
+			attr.ConnectorID: "connector-id",
+			attr.IssuedAt:    formatTime(time.Now()),
+			attr.Status:      model.ConnectorTokensStatusValid,
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		attr.ConnectorID: "connector-id",
+	})
+
+	diff, err := ConnectorTokens().SimpleDiff(context.Background(), state, config, nil)
+	require.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty())
+}
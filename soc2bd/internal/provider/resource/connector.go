@@ -3,11 +3,12 @@ package resource
 import (
 	"context"
 	"errors"
-	"log"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -15,7 +16,7 @@ import (
 var ErrNotAllowChangeRemoteNetworkID = errors.New("connectors cannot be moved between Remote Networks: you must either create a new Connector or destroy and recreate the existing one")
 
 func Connector() *schema.Resource {
-	return &schema.Resource{
+	return withLogging(Soc2bdConnector, &schema.Resource{
 		Description:   "Connectors provide connectivity to Remote Networks. This resource type will create the Connector in the Soc2bd Admin Console, but in order to successfully deploy it, you must also generate Connector tokens that authenticate the Connector with Soc2bd. For more information, see Soc2bd's [documentation](https://docs.soc2bd.com/docs/understanding-access-nodes).",
 		CreateContext: connectorCreate,
 		ReadContext:   connectorRead,
@@ -45,6 +46,7 @@ func Connector() *schema.Resource {
 				Computed:    true,
 				Description: "Name of the Connector, if not provided one will be generated.",
 			},
+			attr.DeletionProtection: deletionProtectionSchema("Connector"),
 			// computed
 			attr.ID: {
 				Type:        schema.TypeString,
@@ -58,14 +60,12 @@ func Connector() *schema.Resource {
 				Description: "Determines whether status notifications are enabled for the Connector.",
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-	}
+		Importer: importer(resolveConnectorImport, []string{importKeyNetwork, importKeyName}),
+	})
 }
 
 func connectorCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 
 	connector, err := c.CreateConnector(ctx, &model.Connector{
 		Name:                 resourceData.Get(attr.Name).(string),
@@ -91,7 +91,7 @@ func connectorUpdate(ctx context.Context, resourceData *schema.ResourceData, met
 		connector.Name = ""
 	}
 
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 
 	connector, err := c.UpdateConnector(ctx, connector)
 
@@ -99,21 +99,25 @@ func connectorUpdate(ctx context.Context, resourceData *schema.ResourceData, met
 }
 
 func connectorDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 	connectorID := resourceData.Id()
 
+	if diags := checkDeletionProtection(meta, Soc2bdConnector, resourceData); diags.HasError() {
+		return diags
+	}
+
 	err := c.DeleteConnector(ctx, connectorID)
 	if err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Destroyed connector id %s", connectorID)
+	tflog.SubsystemInfo(ctx, Soc2bdConnector, "Deleted connector")
 
 	return nil
 }
 
 func connectorRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 	connector, err := c.ReadConnector(ctx, resourceData.Id())
 
 	return resourceConnectorReadHelper(resourceData, connector, err)
@@ -121,14 +125,14 @@ func connectorRead(ctx context.Context, resourceData *schema.ResourceData, meta
 
 func resourceConnectorReadHelper(resourceData *schema.ResourceData, connector *model.Connector, err error) diag.Diagnostics {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if client.IsNotFound(err) {
 			// clear state
 			resourceData.SetId("")
 
 			return nil
 		}
 
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
 	if err := resourceData.Set(attr.Name, connector.Name); err != nil {
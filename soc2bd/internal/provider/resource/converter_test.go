@@ -5,37 +5,33 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestConvertProtocol(t *testing.T) {
 
 	cases := []struct {
-		input       []interface{}
+		input       []protocolModel
 		expected    *model.Protocol
 		expectedErr error
 	}{
 		{},
 		{
-			input: []interface{}{
-				map[string]interface{}{
-					attr.Policy: model.PolicyAllowAll,
-					attr.Ports: []interface{}{
-						"-",
-					},
+			input: []protocolModel{
+				{
+					Policy: types.StringValue(model.PolicyAllowAll),
+					Ports:  types.ListValueMust(types.StringType, stringValues([]string{"-"})),
 				},
 			},
 			expectedErr: errors.New("failed to parse protocols port range"),
 		},
 		{
-			input: []interface{}{
-				map[string]interface{}{
-					attr.Policy: model.PolicyRestricted,
-					attr.Ports: []interface{}{
-						"80-88",
-					},
+			input: []protocolModel{
+				{
+					Policy: types.StringValue(model.PolicyRestricted),
+					Ports:  types.ListValueMust(types.StringType, stringValues([]string{"80-88"})),
 				},
 			},
 			expected: &model.Protocol{
@@ -45,6 +41,17 @@ func TestConvertProtocol(t *testing.T) {
 				},
 			},
 		},
+		{
+			input: []protocolModel{
+				{
+					Policy: types.StringValue(model.PolicyDenyAll),
+					Ports:  types.ListNull(types.StringType),
+				},
+			},
+			expected: &model.Protocol{
+				Policy: model.PolicyRestricted,
+			},
+		},
 	}
 
 	for n, c := range cases {
@@ -127,38 +134,43 @@ func TestConvertPortsRangeToMap(t *testing.T) {
 
 func TestEqualPorts(t *testing.T) {
 	cases := []struct {
-		inputA   []interface{}
-		inputB   []interface{}
+		inputA   []string
+		inputB   []string
 		expected bool
 	}{
 		{
-			inputA:   []interface{}{""},
-			inputB:   []interface{}{""},
+			inputA:   []string{""},
+			inputB:   []string{""},
 			expected: false,
 		},
 		{
-			inputA:   []interface{}{"80"},
-			inputB:   []interface{}{""},
+			inputA:   []string{"80"},
+			inputB:   []string{""},
 			expected: false,
 		},
 		{
-			inputA:   []interface{}{"80"},
-			inputB:   []interface{}{"90"},
+			inputA:   []string{"80"},
+			inputB:   []string{"90"},
 			expected: false,
 		},
 		{
-			inputA:   []interface{}{"80"},
-			inputB:   []interface{}{"80"},
+			inputA:   []string{"80"},
+			inputB:   []string{"80"},
+			expected: true,
+		},
+		{
+			inputA:   []string{"80-81"},
+			inputB:   []string{"80", "81"},
 			expected: true,
 		},
 		{
-			inputA:   []interface{}{"80-81"},
-			inputB:   []interface{}{"80", "81"},
+			inputA:   []string{"80-81", "70"},
+			inputB:   []string{"70", "80", "81"},
 			expected: true,
 		},
 		{
-			inputA:   []interface{}{"80-81", "70"},
-			inputB:   []interface{}{"70", "80", "81"},
+			inputA:   nil,
+			inputB:   []string{},
 			expected: true,
 		},
 	}
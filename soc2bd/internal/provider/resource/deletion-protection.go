@@ -0,0 +1,119 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func deletionProtectionSchema(objectName string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Description: fmt.Sprintf("Prevents the %s from being deleted, destroying it fails until this is set to `false`. "+
+			"When not set, the provider `%s` default applies.", objectName, attr.DeletionProtection),
+	}
+}
+
+// deletionProtection returns the deletion_protection in state, nil when it's not set.
+// The raw state is read as the zero value can't be told apart from an unset value otherwise.
+func deletionProtection(resourceData *schema.ResourceData) *bool {
+	state := resourceData.GetRawState()
+	if state.IsNull() || !state.Type().IsObjectType() || !state.Type().HasAttribute(attr.DeletionProtection) {
+		return nil
+	}
+
+	value := state.GetAttr(attr.DeletionProtection)
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+
+	protected := value.True()
+
+	return &protected
+}
+
+// isDeletionProtected reports whether the object can't be deleted: its own setting wins over the provider default level.
+func isDeletionProtected(protection *bool, level string) bool {
+	if protection != nil {
+		return *protection
+	}
+
+	return level == model.DeletionProtectionAll
+}
+
+// deletionProtectedDiagnostics is the error of deleting a protected object.
+func deletionProtectedDiagnostics(resourceType, id string) diag.Diagnostics {
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Cannot delete %s with ID %s: deletion protection is enabled", resourceType, id),
+			Detail: fmt.Sprintf("Set `%s = false` on the object and apply before destroying it, "+
+				"or lower the provider `%s` default when the object doesn't set it.", attr.DeletionProtection, attr.DeletionProtection),
+		},
+	}
+}
+
+// checkDeletionProtection returns the diagnostics preventing the deletion of the object, if it's protected.
+func checkDeletionProtection(meta interface{}, resourceType string, resourceData *schema.ResourceData) diag.Diagnostics {
+	if isDeletionProtected(deletionProtection(resourceData), meta.(*provider.Meta).DeletionProtection) {
+		return deletionProtectedDiagnostics(resourceType, resourceData.Id())
+	}
+
+	return nil
+}
+
+// checkRemoteNetworkDeletionProtection also prevents deleting remote networks that still have resources or connectors,
+// when the provider default level asks for it and the remote network doesn't set its deletion protection.
+func checkRemoteNetworkDeletionProtection(ctx context.Context, meta interface{}, resourceData *schema.ResourceData) diag.Diagnostics {
+	protection := deletionProtection(resourceData)
+	level := meta.(*provider.Meta).DeletionProtection
+
+	if isDeletionProtected(protection, level) {
+		return deletionProtectedDiagnostics(Soc2bdRemoteNetwork, resourceData.Id())
+	}
+
+	if protection != nil || level != model.DeletionProtectionNonEmptyRemoteNetworks {
+		return nil
+	}
+
+	c := meta.(*provider.Meta).Client
+
+	resources, err := c.ReadResources(ctx)
+	if err != nil {
+		return ErrDiagnostics(err)
+	}
+
+	connectors, err := c.ReadRemoteNetworkConnectors(ctx, resourceData.Id())
+	if err != nil && !client.IsNotFound(err) {
+		return ErrDiagnostics(err)
+	}
+
+	var resourceCount int
+
+	for _, resource := range resources {
+		if resource.RemoteNetworkID == resourceData.Id() {
+			resourceCount++
+		}
+	}
+
+	if resourceCount == 0 && len(connectors) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary: fmt.Sprintf("Cannot delete %s with ID %s: it still has %d Resources and %d Connectors",
+				Soc2bdRemoteNetwork, resourceData.Id(), resourceCount, len(connectors)),
+			Detail: fmt.Sprintf("The provider `%s` is %s, delete the Resources and Connectors of the Remote Network first, "+
+				"or set `%s = false` on it to delete it anyway.", attr.DeletionProtection, model.DeletionProtectionNonEmptyRemoteNetworks, attr.DeletionProtection),
+		},
+	}
+}
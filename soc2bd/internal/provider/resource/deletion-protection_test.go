@@ -0,0 +1,85 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsDeletionProtected(t *testing.T) {
+	enabled, disabled := true, false
+
+	cases := []struct {
+		name       string
+		protection *bool
+		level      string
+		expected   bool
+	}{
+		{name: "not set", level: model.DeletionProtectionNone, expected: false},
+		{name: "enabled", protection: &enabled, level: model.DeletionProtectionNone, expected: true},
+		{name: "provider default", level: model.DeletionProtectionAll, expected: true},
+		{name: "disabled over provider default", protection: &disabled, level: model.DeletionProtectionAll, expected: false},
+		{name: "non empty remote networks", level: model.DeletionProtectionNonEmptyRemoteNetworks, expected: false},
+	}
+
+	for _, c := range cases {
+		t.Run("Test Soc2bd Resource : Is Deletion Protected "+c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, isDeletionProtected(c.protection, c.level))
+		})
+	}
+}
+
+func TestCheckDeletionProtection(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Check Deletion Protection Provider Default", func(t *testing.T) {
+		resourceData := schema.TestResourceDataRaw(t, Group().Schema, map[string]interface{}{attr.Name: "group"})
+		resourceData.SetId("group-id")
+
+		assert.Nil(t, deletionProtection(resourceData))
+		assert.False(t, checkDeletionProtection(&provider.Meta{Client: client.NewClient(), DeletionProtection: model.DeletionProtectionNone}, Soc2bdGroup, resourceData).HasError())
+
+		diags := checkDeletionProtection(&provider.Meta{Client: client.NewClient(), DeletionProtection: model.DeletionProtectionAll}, Soc2bdGroup, resourceData)
+		if assert.True(t, diags.HasError()) {
+			assert.Equal(t, "Cannot delete soc2bd_group with ID group-id: deletion protection is enabled", diags[0].Summary)
+		}
+	})
+}
+
+func TestDeletionProtectionOfEveryResource(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		Soc2bdConnector:         Connector(),
+		Soc2bdConnectorTokens:   ConnectorTokens(),
+		Soc2bdGroup:             Group(),
+		Soc2bdGroupMembership:   GroupMembership(),
+		Soc2bdRemoteNetwork:     RemoteNetwork(),
+		Soc2bdResourceAccess:    ResourceAccess(),
+		Soc2bdSecurityPolicy:    SecurityPolicy(),
+		Soc2bdServiceAccount:    ServiceAccount(),
+		Soc2bdServiceAccountKey: ServiceKey(),
+		Soc2bdUser:              User(),
+	}
+
+	meta := &provider.Meta{Client: client.NewClient(), DeletionProtection: model.DeletionProtectionAll}
+
+	for resourceType, res := range resources {
+		t.Run("Test Soc2bd Resource : Deletion Protection Of "+resourceType, func(t *testing.T) {
+			require.Contains(t, res.Schema, attr.DeletionProtection)
+			require.NoError(t, res.InternalValidate(nil, true))
+
+			resourceData := res.TestResourceData()
+			resourceData.SetId("object-id")
+
+			diags := res.DeleteContext(context.Background(), resourceData, meta)
+			if assert.True(t, diags.HasError()) {
+				assert.Equal(t, fmt.Sprintf("Cannot delete %s with ID object-id: deletion protection is enabled", resourceType), diags[0].Summary)
+			}
+		})
+	}
+}
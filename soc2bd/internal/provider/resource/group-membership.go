@@ -0,0 +1,173 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/utils"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var ErrInvalidGroupMembershipID = errors.New("invalid group membership id, expected `<group_id>/<user_id>`")
+
+func GroupMembership() *schema.Resource {
+	return withLogging(Soc2bdGroupMembership, &schema.Resource{
+		Description: "Group memberships add a single User to a Group, so the members of a shared Group can be managed separately, e.g. by different teams. " +
+			"Use it with Groups which don't manage their users: a `soc2bd_group` with `is_authoritative` set to `true` removes the users it doesn't list.",
+		CreateContext: groupMembershipCreate,
+		ReadContext:   groupMembershipRead,
+		UpdateContext: groupMembershipUpdate,
+		DeleteContext: groupMembershipDelete,
+
+		Schema: map[string]*schema.Schema{
+			attr.GroupID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the Group",
+			},
+			attr.UserID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the User to add to the Group",
+			},
+			attr.DeletionProtection: deletionProtectionSchema("Group Membership"),
+			// computed
+			attr.ID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the membership, in the format `<group_id>/<user_id>`",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: groupMembershipImport,
+		},
+	})
+}
+
+func groupMembershipCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*provider.Meta).Client
+	groupID := resourceData.Get(attr.GroupID).(string)
+	userID := resourceData.Get(attr.UserID).(string)
+
+	group, err := isAllowedToChangeGroup(ctx, groupID, c)
+	if err != nil {
+		return ErrDiagnostics(err)
+	}
+
+	// addedUserIds only adds the user, the other members of the group are left as they are
+	if _, err := c.UpdateGroup(ctx, &model.Group{
+		ID:               group.ID,
+		Name:             group.Name,
+		SecurityPolicyID: group.SecurityPolicyID,
+		Users:            []string{userID},
+	}); err != nil {
+		return ErrDiagnostics(err)
+	}
+
+	tflog.SubsystemInfo(ctx, Soc2bdGroupMembership, "Added user to group", map[string]interface{}{attr.UserID: userID, attr.GroupID: groupID})
+
+	resourceData.SetId(compositeID(groupID, userID))
+
+	return groupMembershipRead(ctx, resourceData, meta)
+}
+
+func groupMembershipRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*provider.Meta).Client
+
+	groupID, userID, err := parseGroupMembershipID(resourceData.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	group, err := c.ReadGroup(ctx, groupID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			// clear state
+			resourceData.SetId("")
+
+			return nil
+		}
+
+		return ErrDiagnostics(err)
+	}
+
+	if !utils.MakeLookupMap(group.Users)[userID] {
+		tflog.SubsystemWarn(ctx, Soc2bdGroupMembership, "User is not a member of the group anymore", map[string]interface{}{attr.UserID: userID, attr.GroupID: groupID})
+
+		// clear state
+		resourceData.SetId("")
+
+		return nil
+	}
+
+	if err := resourceData.Set(attr.GroupID, groupID); err != nil {
+		return ErrAttributeSet(err, attr.GroupID)
+	}
+
+	if err := resourceData.Set(attr.UserID, userID); err != nil {
+		return ErrAttributeSet(err, attr.UserID)
+	}
+
+	return nil
+}
+
+func groupMembershipUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// only the deletion protection can be updated in place
+	return groupMembershipRead(ctx, resourceData, meta)
+}
+
+func groupMembershipDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*provider.Meta).Client
+
+	if diags := checkDeletionProtection(meta, Soc2bdGroupMembership, resourceData); diags.HasError() {
+		return diags
+	}
+
+	groupID, userID, err := parseGroupMembershipID(resourceData.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := isAllowedToChangeGroup(ctx, groupID, c); err != nil {
+		if client.IsNotFound(err) {
+			return nil
+		}
+
+		return ErrDiagnostics(err)
+	}
+
+	if err := c.DeleteGroupUsers(ctx, groupID, []string{userID}); err != nil {
+		return ErrDiagnostics(err)
+	}
+
+	tflog.SubsystemInfo(ctx, Soc2bdGroupMembership, "Removed user from group", map[string]interface{}{attr.UserID: userID, attr.GroupID: groupID})
+
+	return nil
+}
+
+func groupMembershipImport(_ context.Context, resourceData *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseGroupMembershipID(resourceData.Id()); err != nil {
+		return nil, err
+	}
+
+	// the attributes are set when the imported membership is read
+	return []*schema.ResourceData{resourceData}, nil
+}
+
+func parseGroupMembershipID(id string) (groupID, userID string, err error) {
+	ids, err := parseCompositeID(id, attr.GroupID, attr.UserID)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: got `%s`", ErrInvalidGroupMembershipID, id)
+	}
+
+	return ids[0], ids[1], nil
+}
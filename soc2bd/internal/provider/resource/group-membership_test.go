@@ -0,0 +1,39 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGroupMembershipID(t *testing.T) {
+	cases := []struct {
+		id      string
+		groupID string
+		userID  string
+		isValid bool
+	}{
+		{id: "R3JvdXA6MQ==/VXNlcjox", groupID: "R3JvdXA6MQ==", userID: "VXNlcjox", isValid: true},
+		{id: compositeID("group-id", "user-id"), groupID: "group-id", userID: "user-id", isValid: true},
+		{id: "group-id"},
+		{id: "group-id/"},
+		{id: "/user-id"},
+		{id: "group-id/user-id/other"},
+	}
+
+	for _, c := range cases {
+		t.Run("Test Soc2bd Resource : Parse Group Membership ID "+c.id, func(t *testing.T) {
+			groupID, userID, err := parseGroupMembershipID(c.id)
+
+			if !c.isValid {
+				assert.ErrorIs(t, err, ErrInvalidGroupMembershipID)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, c.groupID, groupID)
+			assert.Equal(t, c.userID, userID)
+		})
+	}
+}
@@ -2,13 +2,13 @@ package resource
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -18,7 +18,7 @@ func ErrAllowedToChangeOnlyManualGroups(group *model.Group) error {
 }
 
 func Group() *schema.Resource {
-	return &schema.Resource{
+	return withLogging(Soc2bdGroup, &schema.Resource{
 		Description:   "Groups are how users are authorized to access Resources. For more information, see Soc2bd's [documentation](https://docs.soc2bd.com/docs/groups).",
 		CreateContext: groupCreate,
 		ReadContext:   groupRead,
@@ -44,6 +44,7 @@ func Group() *schema.Resource {
 				Optional:    true,
 				Description: "List of User IDs that have permission to access the Group.",
 			},
+			attr.DeletionProtection: deletionProtectionSchema("Group"),
 			// computed
 			attr.SecurityPolicyID: {
 				Type:        schema.TypeString,
@@ -57,46 +58,44 @@ func Group() *schema.Resource {
 				Description: "Autogenerated ID of the Resource, encoded in base64",
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-	}
+		Importer: importer(resolveGroupImport, []string{importKeyName}),
+	})
 }
 
 func groupCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 
 	group, err := c.CreateGroup(ctx, convertGroup(resourceData))
 	if err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Group %s created with id %v", group.Name, group.ID)
+	tflog.SubsystemInfo(ctx, Soc2bdGroup, "Created group", map[string]interface{}{client.LogFieldEntityID: group.ID, attr.Name: group.Name})
 
 	return resourceGroupReadHelper(resourceData, group, nil)
 }
 
 func groupUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*client.Client)
+	client := meta.(*provider.Meta).Client
 	group := convertGroup(resourceData)
 
 	remoteGroup, err := isAllowedToChangeGroup(ctx, group.ID, client)
 	if err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
 	oldIDs := getOldGroupUserIDs(resourceData, group, remoteGroup)
 	if err := client.DeleteGroupUsers(ctx, group.ID, setDifference(oldIDs, group.Users)); err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
 	group, err = client.UpdateGroup(ctx, group)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Updated group id %v", group.ID)
+	tflog.SubsystemInfo(ctx, Soc2bdGroup, "Updated group")
 
 	return resourceGroupReadHelper(resourceData, group, err)
 }
@@ -112,18 +111,22 @@ func getOldGroupUserIDs(resourceData *schema.ResourceData, group, remoteGroup *m
 }
 
 func groupDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*client.Client)
+	client := meta.(*provider.Meta).Client
 	groupID := resourceData.Id()
 
+	if diags := checkDeletionProtection(meta, Soc2bdGroup, resourceData); diags.HasError() {
+		return diags
+	}
+
 	if _, err := isAllowedToChangeGroup(ctx, groupID, client); err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
 	if err := client.DeleteGroup(ctx, groupID); err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Deleted group id %s", resourceData.Id())
+	tflog.SubsystemInfo(ctx, Soc2bdGroup, "Deleted group")
 
 	return nil
 }
@@ -142,7 +145,7 @@ func isAllowedToChangeGroup(ctx context.Context, groupID string, client *client.
 }
 
 func groupRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 
 	group, err := c.ReadGroup(ctx, resourceData.Id())
 	if group != nil {
@@ -154,14 +157,14 @@ func groupRead(ctx context.Context, resourceData *schema.ResourceData, meta inte
 
 func resourceGroupReadHelper(resourceData *schema.ResourceData, group *model.Group, err error) diag.Diagnostics {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if client.IsNotFound(err) {
 			// clear state
 			resourceData.SetId("")
 
 			return nil
 		}
 
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
 	resourceData.SetId(group.ID)
@@ -3,7 +3,12 @@ package resource
 import (
 	"fmt"
 
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/utils"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	fwattr "github.com/hashicorp/terraform-plugin-framework/attr"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -12,6 +17,43 @@ func ErrAttributeSet(err error, attribute string) diag.Diagnostics {
 	return diag.FromErr(fmt.Errorf("error setting %s: %w ", attribute, err))
 }
 
+// ErrDiagnostics converts the error to diagnostics, with a hint on how to resolve it depending on the API error kind.
+func ErrDiagnostics(err error) diag.Diagnostics {
+	var detail string
+
+	switch client.ErrorKindOf(err) {
+	case client.ErrorKindNotFound:
+		detail = "The object doesn't exist anymore in Soc2bd, refresh the state to plan its recreation."
+	case client.ErrorKindPermissionDenied:
+		detail = "The API token is not allowed to perform this operation, check it has the Read, Write & Provision permissions in the Admin Console."
+	case client.ErrorKindValidation:
+		detail = "Soc2bd API rejected the request, check the values set in the configuration."
+	case client.ErrorKindConflict:
+		detail = "The object was changed concurrently or conflicts with an existing one, refresh the state and retry."
+	case client.ErrorKindThrottled:
+		detail = fmt.Sprintf("Soc2bd API is throttling requests, retry later or lower the `%s` provider setting.", attr.HTTPRateLimit)
+	case client.ErrorKindServerError:
+		detail = "Soc2bd API failed to process the request, retry later."
+	case client.ErrorKindUnknown:
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  err.Error(),
+			Detail:   detail,
+		},
+	}
+}
+
+// addErrDiagnostics adds the error to the plugin framework diagnostics, with the same hint as ErrDiagnostics.
+func addErrDiagnostics(diags *fwdiag.Diagnostics, err error) {
+	for _, d := range ErrDiagnostics(err) {
+		diags.AddError(d.Summary, d.Detail)
+	}
+}
+
 func castToStrings(a, b interface{}) (string, string) {
 	return a.(string), b.(string)
 }
@@ -85,6 +127,31 @@ func boolPtr(b bool) *bool {
 	return &b
 }
 
+func convertUsers(data *schema.ResourceData) []string {
+	if ids, ok := data.GetOk(attr.UserIDs); ok {
+		return convertIDs(ids)
+	}
+
+	return nil
+}
+
+func convertAuthoritativeFlag(data *schema.ResourceData) bool {
+	flag, hasFlag := data.GetOkExists(attr.IsAuthoritative) //nolint:staticcheck
+
+	if hasFlag {
+		return flag.(bool)
+	}
+
+	// default value
+	return true
+}
+
+func stringValues(values []string) []fwattr.Value {
+	return utils.Map(values, func(value string) fwattr.Value {
+		return types.StringValue(value)
+	})
+}
+
 func withDefaultValue(str, defaultValue string) string {
 	if str != "" {
 		return str
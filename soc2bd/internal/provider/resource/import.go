@@ -0,0 +1,306 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/utils"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	importKeyName    = "name"
+	importKeyNetwork = "network"
+	importKeyAddress = "address"
+	importKeyEmail   = "email"
+
+	compositeIDSeparator = "/"
+)
+
+var (
+	ErrImportNotFound     = errors.New("no object matches the import id")
+	ErrUnknownImportIDFmt = errors.New("unsupported import id format")
+	ErrInvalidCompositeID = errors.New("invalid id")
+
+	// matches the `key:` prefixes of an import ID like `network:aws-prod/address:10.0.0.0/16`,
+	// values may contain slashes, e.g. CIDR addresses.
+	importKeyRe = regexp.MustCompile(`(?:^|/)(` + strings.Join([]string{importKeyName, importKeyNetwork, importKeyAddress, importKeyEmail}, "|") + `):`)
+)
+
+// importRef is a parsed import ID referencing an object by its attributes instead of its ID.
+type importRef struct {
+	keys   []string
+	values map[string]string
+}
+
+// parseImportID parses import IDs like `name:prod-db` or `network:aws-prod/name:connector-1`,
+// ok is false for plain object IDs.
+func parseImportID(importID string) (ref importRef, ok bool) {
+	matches := importKeyRe.FindAllStringSubmatchIndex(importID, -1)
+	if len(matches) == 0 || matches[0][0] != 0 {
+		return ref, false
+	}
+
+	ref.values = make(map[string]string, len(matches))
+
+	for i, match := range matches {
+		end := len(importID)
+		if i+1 < len(matches) {
+			end = matches[i+1][0]
+		}
+
+		key := importID[match[2]:match[3]]
+		ref.keys = append(ref.keys, key)
+		ref.values[key] = importID[match[1]:end]
+	}
+
+	return ref, true
+}
+
+func (r importRef) get(key string) string {
+	return r.values[key]
+}
+
+// is checks the import ID has exactly the given keys, in that order.
+func (r importRef) is(keys ...string) bool {
+	if len(r.keys) != len(keys) {
+		return false
+	}
+
+	for i, key := range keys {
+		if r.keys[i] != key || r.values[key] == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r importRef) String() string {
+	parts := make([]string, 0, len(r.keys))
+	for _, key := range r.keys {
+		parts = append(parts, key+":"+r.values[key])
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// importFormats lists the supported import ID formats, e.g. `network:<network>/name:<name>`.
+func importFormats(formats ...[]string) string {
+	result := make([]string, 0, len(formats))
+
+	for _, keys := range formats {
+		parts := utils.Map(keys, func(key string) string {
+			return fmt.Sprintf("%s:<%s>", key, key)
+		})
+
+		result = append(result, "`"+strings.Join(parts, "/")+"`")
+	}
+
+	return strings.Join(result, ", ")
+}
+
+type importResolver func(ctx context.Context, c *client.Client, ref importRef) (string, error)
+
+// importer imports objects by their ID or by the attributes given in the supported formats,
+// resolving them to the object ID with the resolver.
+func importer(resolve importResolver, formats ...[]string) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			id, err := resolveImportID(ctx, meta.(*provider.Meta).Client, resourceData.Id(), resolve, formats...)
+			if err != nil {
+				return nil, err
+			}
+
+			resourceData.SetId(id)
+
+			return []*schema.ResourceData{resourceData}, nil
+		},
+	}
+}
+
+// resolveImportID returns the object ID for the import ID, which is either the object ID
+// or its attributes in one of the supported formats.
+func resolveImportID(ctx context.Context, c *client.Client, importID string, resolve importResolver, formats ...[]string) (string, error) {
+	ref, ok := parseImportID(importID)
+	if !ok {
+		return importID, nil
+	}
+
+	for _, keys := range formats {
+		if ref.is(keys...) {
+			return resolve(ctx, c, ref)
+		}
+	}
+
+	return "", fmt.Errorf("%w `%s`, expected the object id or one of: %s", ErrUnknownImportIDFmt, ref, importFormats(formats...))
+}
+
+// compositeID is the ID of the resources linking two objects, e.g. `<group_id>/<user_id>`.
+func compositeID(ids ...string) string {
+	return strings.Join(ids, compositeIDSeparator)
+}
+
+// parseCompositeID splits the composite ID into the IDs of the objects named by keys.
+func parseCompositeID(id string, keys ...string) ([]string, error) {
+	ids := strings.Split(id, compositeIDSeparator)
+
+	if len(ids) != len(keys) || utils.Contains(ids, "") {
+		expected := utils.Map(keys, func(key string) string {
+			return "<" + key + ">"
+		})
+
+		return nil, fmt.Errorf("%w `%s`, expected `%s`", ErrInvalidCompositeID, id, strings.Join(expected, compositeIDSeparator))
+	}
+
+	return ids, nil
+}
+
+// uniqueImportMatch returns the ID of the single object matching the import ID.
+func uniqueImportMatch[T interface{ GetID() string }](ref importRef, matches []T) (string, error) {
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("%w `%s`", ErrImportNotFound, ref)
+	case 1:
+		return matches[0].GetID(), nil
+	}
+
+	ids := utils.Map(matches, func(item T) string {
+		return item.GetID()
+	})
+
+	return "", fmt.Errorf("import id `%s` is ambiguous, it matches %d objects with ids %s: import by id instead", //nolint:goerr113
+		ref, len(matches), strings.Join(ids, ", "))
+}
+
+func readImportNetworkID(ctx context.Context, c *client.Client, ref importRef) (string, error) {
+	network, err := c.ReadRemoteNetworkByName(ctx, ref.get(importKeyNetwork))
+	if err != nil {
+		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+			return "", fmt.Errorf("%w `%s`: remote network %s not found", ErrImportNotFound, ref, ref.get(importKeyNetwork))
+		}
+
+		return "", err //nolint:wrapcheck
+	}
+
+	return network.ID, nil
+}
+
+func resolveResourceImport(ctx context.Context, c *client.Client, ref importRef) (string, error) {
+	if ref.is(importKeyName) {
+		resources, err := c.ReadResourcesByName(ctx, ref.get(importKeyName))
+		if err = client.IgnoreEmptyResult(err); err != nil {
+			return "", err
+		}
+
+		return uniqueImportMatch(ref, resources)
+	}
+
+	networkID, err := readImportNetworkID(ctx, c, ref)
+	if err != nil {
+		return "", err
+	}
+
+	var resources []*model.Resource
+
+	if ref.is(importKeyNetwork, importKeyName) {
+		resources, err = c.ReadResourcesByName(ctx, ref.get(importKeyName))
+	} else {
+		resources, err = c.ReadResources(ctx)
+	}
+
+	if err = client.IgnoreEmptyResult(err); err != nil {
+		return "", err
+	}
+
+	return uniqueImportMatch(ref, utils.Filter(resources, func(resource *model.Resource) bool {
+		if resource.RemoteNetworkID != networkID {
+			return false
+		}
+
+		if address := ref.get(importKeyAddress); address != "" {
+			return resource.Address == address
+		}
+
+		return true
+	}))
+}
+
+func resolveConnectorImport(ctx context.Context, c *client.Client, ref importRef) (string, error) {
+	networkID, err := readImportNetworkID(ctx, c, ref)
+	if err != nil {
+		return "", err
+	}
+
+	connectors, err := c.ReadConnectors(ctx)
+	if err = client.IgnoreEmptyResult(err); err != nil {
+		return "", err
+	}
+
+	return uniqueImportMatch(ref, utils.Filter(connectors, func(connector *model.Connector) bool {
+		return connector.NetworkID == networkID && connector.Name == ref.get(importKeyName)
+	}))
+}
+
+func resolveRemoteNetworkImport(ctx context.Context, c *client.Client, ref importRef) (string, error) {
+	network, err := c.ReadRemoteNetworkByName(ctx, ref.get(importKeyName))
+	if err = client.IgnoreEmptyResult(err); err != nil {
+		return "", err
+	}
+
+	if network == nil {
+		return uniqueImportMatch[*model.RemoteNetwork](ref, nil)
+	}
+
+	return network.ID, nil
+}
+
+func resolveGroupImport(ctx context.Context, c *client.Client, ref importRef) (string, error) {
+	name := ref.get(importKeyName)
+
+	groups, err := c.ReadShallowGroups(ctx, &model.GroupsFilter{Name: &name})
+	if err = client.IgnoreEmptyResult(err); err != nil {
+		return "", err
+	}
+
+	return uniqueImportMatch(ref, groups)
+}
+
+func resolveUserImport(ctx context.Context, c *client.Client, ref importRef) (string, error) {
+	users, err := c.ReadUsers(ctx)
+	if err = client.IgnoreEmptyResult(err); err != nil {
+		return "", err
+	}
+
+	return uniqueImportMatch(ref, utils.Filter(users, func(user *model.User) bool {
+		return strings.EqualFold(user.Email, ref.get(importKeyEmail))
+	}))
+}
+
+func resolveServiceAccountImport(ctx context.Context, c *client.Client, ref importRef) (string, error) {
+	serviceAccounts, err := c.ReadShallowServiceAccounts(ctx)
+	if err = client.IgnoreEmptyResult(err); err != nil {
+		return "", err
+	}
+
+	return uniqueImportMatch(ref, utils.Filter(serviceAccounts, func(serviceAccount *model.ServiceAccount) bool {
+		return serviceAccount.Name == ref.get(importKeyName)
+	}))
+}
+
+func resolveSecurityPolicyImport(ctx context.Context, c *client.Client, ref importRef) (string, error) {
+	policies, err := c.ReadSecurityPolicies(ctx)
+	if err = client.IgnoreEmptyResult(err); err != nil {
+		return "", err
+	}
+
+	return uniqueImportMatch(ref, utils.Filter(policies, func(policy *model.SecurityPolicy) bool {
+		return policy.Name == ref.get(importKeyName)
+	}))
+}
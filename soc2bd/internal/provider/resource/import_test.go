@@ -0,0 +1,101 @@
+package resource
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImportID(t *testing.T) {
+	cases := []struct {
+		importID string
+		ok       bool
+		keys     []string
+		values   map[string]string
+	}{
+		{
+			importID: "UmVzb3VyY2U6MTAxMzI=",
+			ok:       false,
+		},
+		{
+			importID: "name:prod-db",
+			ok:       true,
+			keys:     []string{importKeyName},
+			values:   map[string]string{importKeyName: "prod-db"},
+		},
+		{
+			importID: "network:aws-prod/address:10.0.0.0/16",
+			ok:       true,
+			keys:     []string{importKeyNetwork, importKeyAddress},
+			values:   map[string]string{importKeyNetwork: "aws-prod", importKeyAddress: "10.0.0.0/16"},
+		},
+		{
+			importID: "email:alice@corp.com",
+			ok:       true,
+			keys:     []string{importKeyEmail},
+			values:   map[string]string{importKeyEmail: "alice@corp.com"},
+		},
+		{
+			importID: "network:aws-prod/name:connector-1",
+			ok:       true,
+			keys:     []string{importKeyNetwork, importKeyName},
+			values:   map[string]string{importKeyNetwork: "aws-prod", importKeyName: "connector-1"},
+		},
+		{
+			importID: "name:with/slash:and-colon",
+			ok:       true,
+			keys:     []string{importKeyName},
+			values:   map[string]string{importKeyName: "with/slash:and-colon"},
+		},
+		{
+			importID: "prefix/name:prod-db",
+			ok:       false,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			ref, ok := parseImportID(c.importID)
+
+			assert.Equal(t, c.ok, ok)
+			assert.Equal(t, c.keys, ref.keys)
+			assert.Equal(t, c.values, ref.values)
+
+			if ok {
+				assert.Equal(t, c.importID, ref.String())
+			}
+		})
+	}
+}
+
+func TestImportRefIs(t *testing.T) {
+	ref, _ := parseImportID("network:aws-prod/name:connector-1")
+
+	assert.True(t, ref.is(importKeyNetwork, importKeyName))
+	assert.False(t, ref.is(importKeyName, importKeyNetwork))
+	assert.False(t, ref.is(importKeyName))
+
+	ref, _ = parseImportID("name:")
+	assert.False(t, ref.is(importKeyName))
+}
+
+func TestImportFormats(t *testing.T) {
+	assert.Equal(t, "`name:<name>`, `network:<network>/address:<address>`",
+		importFormats([]string{importKeyName}, []string{importKeyNetwork, importKeyAddress}))
+}
+
+func TestUniqueImportMatch(t *testing.T) {
+	ref, _ := parseImportID("name:prod-db")
+
+	id, err := uniqueImportMatch(ref, []*model.Resource{{ID: "id-1"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "id-1", id)
+
+	_, err = uniqueImportMatch[*model.Resource](ref, nil)
+	assert.ErrorIs(t, err, ErrImportNotFound)
+
+	_, err = uniqueImportMatch(ref, []*model.Resource{{ID: "id-1"}, {ID: "id-2"}})
+	assert.EqualError(t, err, "import id `name:prod-db` is ambiguous, it matches 2 objects with ids id-1, id-2: import by id instead")
+}
@@ -0,0 +1,73 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	operationCreate = "create"
+	operationRead   = "read"
+	operationUpdate = "update"
+	operationDelete = "delete"
+	operationPlan   = "plan"
+)
+
+// logContext returns the context with a logger for the subsystem named after the resource type,
+// tagged with the operation, the entity ID and the client correlation ID.
+func logContext(ctx context.Context, c *client.Client, resourceType, operation, id string) context.Context {
+	ctx = client.NewLogSubsystem(ctx, resourceType)
+	ctx = tflog.SubsystemSetField(ctx, resourceType, client.LogFieldOperation, operation)
+
+	if id != "" {
+		ctx = tflog.SubsystemSetField(ctx, resourceType, client.LogFieldEntityID, id)
+	}
+
+	if c != nil {
+		ctx = tflog.SubsystemSetField(ctx, resourceType, client.LogFieldCorrelationID, c.CorrelationID())
+	}
+
+	return ctx
+}
+
+// withLogging sets up the resource type logger for every CRUD function and the plan customization of the resource.
+func withLogging(resourceType string, res *schema.Resource) *schema.Resource {
+	res.CreateContext = logCRUD(resourceType, operationCreate, res.CreateContext)
+	res.ReadContext = logCRUD(resourceType, operationRead, res.ReadContext)
+	res.UpdateContext = logCRUD(resourceType, operationUpdate, res.UpdateContext)
+	res.DeleteContext = logCRUD(resourceType, operationDelete, res.DeleteContext)
+
+	if customizeDiff := res.CustomizeDiff; customizeDiff != nil {
+		res.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return customizeDiff(logContext(ctx, metaClient(meta), resourceType, operationPlan, diff.Id()), diff, meta)
+		}
+	}
+
+	return res
+}
+
+type crudFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+func logCRUD(resourceType, operation string, crud crudFunc) crudFunc {
+	if crud == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return crud(logContext(ctx, metaClient(meta), resourceType, operation, resourceData.Id()), resourceData, meta)
+	}
+}
+
+// metaClient returns the client of the provider meta, nil when the provider isn't configured.
+func metaClient(meta interface{}) *client.Client {
+	if m, ok := meta.(*provider.Meta); ok && m != nil {
+		return m.Client
+	}
+
+	return nil
+}
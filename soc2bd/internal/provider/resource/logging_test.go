@@ -0,0 +1,63 @@
+package resource
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithLogging(t *testing.T) {
+	t.Run("Test Soc2bd Resource : With Logging", func(t *testing.T) {
+		var output bytes.Buffer
+
+		res := withLogging(Soc2bdServiceAccountKey, &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				attr.Token: {Type: schema.TypeString, Computed: true},
+			},
+			ReadContext: func(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				ctx = client.MaskLogValues(ctx, Soc2bdServiceAccountKey, "service-key-token")
+				tflog.SubsystemInfo(ctx, Soc2bdServiceAccountKey, "Read service key", map[string]interface{}{
+					attr.Token: "service-key-token",
+					"detail":   "token service-key-token",
+				})
+
+				return nil
+			},
+		})
+
+		assert.Nil(t, res.CreateContext)
+
+		resourceData := res.TestResourceData()
+		resourceData.SetId("key-id")
+
+		c := client.NewClient()
+		ctx := tflogtest.RootLogger(context.Background(), &output)
+
+		require.False(t, res.ReadContext(ctx, resourceData, &provider.Meta{Client: c}).HasError())
+
+		entries, err := tflogtest.MultilineJSONDecode(&output)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+
+		assert.Equal(t, map[string]interface{}{
+			"@level":                     "info",
+			"@message":                   "Read service key",
+			"@module":                    "provider.soc2bd_service_account_key",
+			client.LogFieldOperation:     operationRead,
+			client.LogFieldEntityID:      "key-id",
+			client.LogFieldCorrelationID: c.CorrelationID(),
+			attr.Token:                   "***",
+			"detail":                     "token ***",
+		}, entries[0])
+	})
+}
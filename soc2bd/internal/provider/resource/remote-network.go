@@ -2,21 +2,21 @@ package resource
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func RemoteNetwork() *schema.Resource {
-	return &schema.Resource{
+	return withLogging(Soc2bdRemoteNetwork, &schema.Resource{
 		Description:   "A Remote Network represents a single private network in Soc2bd that can have one or more Connectors and Resources assigned to it. You must create a Remote Network before creating Resources and Connectors that belong to it. For more information, see Soc2bd's [documentation](https://docs.soc2bd.com/docs/remote-networks).",
 		CreateContext: remoteNetworkCreate,
 		ReadContext:   remoteNetworkRead,
@@ -41,71 +41,80 @@ func RemoteNetwork() *schema.Resource {
 				Description:  fmt.Sprintf("The location of the Remote Network. Must be one of the following: %s.", strings.Join(model.Locations, ", ")),
 				Default:      model.LocationOther,
 			},
+			attr.DeletionProtection: deletionProtectionSchema("Remote Network"),
+			// computed
+			attr.HealthyConnectors: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of Connectors in the Remote Network that are online. Use it in checks or postconditions to require redundant Connectors.",
+			},
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-	}
+		Importer: importer(resolveRemoteNetworkImport, []string{importKeyName}),
+	})
 }
 
 func remoteNetworkCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 	remoteNetwork, err := c.CreateRemoteNetwork(ctx, &model.RemoteNetwork{
 		Name:     resourceData.Get(attr.Name).(string),
 		Location: resourceData.Get(attr.Location).(string),
 	})
 
-	return resourceRemoteNetworkReadHelper(resourceData, remoteNetwork, err)
+	return resourceRemoteNetworkReadHelper(ctx, c, resourceData, remoteNetwork, err)
 }
 
 func remoteNetworkUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	log.Printf("[INFO] Updating remote network id %s", resourceData.Id())
+	tflog.SubsystemInfo(ctx, Soc2bdRemoteNetwork, "Updating remote network")
 
 	var name string
 	if resourceData.HasChange(attr.Name) {
 		name = resourceData.Get(attr.Name).(string)
 	}
 
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 	remoteNetwork, err := c.UpdateRemoteNetwork(ctx, &model.RemoteNetwork{
 		ID:       resourceData.Id(),
 		Name:     name,
 		Location: resourceData.Get(attr.Location).(string),
 	})
 
-	return resourceRemoteNetworkReadHelper(resourceData, remoteNetwork, err)
+	return resourceRemoteNetworkReadHelper(ctx, c, resourceData, remoteNetwork, err)
 }
 
 func remoteNetworkDelete(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*client.Client)
+	c := m.(*provider.Meta).Client
+
+	if diags := checkRemoteNetworkDeletionProtection(ctx, m, resourceData); diags.HasError() {
+		return diags
+	}
 
 	err := c.DeleteRemoteNetwork(ctx, resourceData.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Deleted remote network id %s", resourceData.Id())
+	tflog.SubsystemInfo(ctx, Soc2bdRemoteNetwork, "Deleted remote network")
 
 	return nil
 }
 
 func remoteNetworkRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 	remoteNetwork, err := c.ReadRemoteNetworkByID(ctx, resourceData.Id())
 
-	return resourceRemoteNetworkReadHelper(resourceData, remoteNetwork, err)
+	return resourceRemoteNetworkReadHelper(ctx, c, resourceData, remoteNetwork, err)
 }
 
-func resourceRemoteNetworkReadHelper(resourceData *schema.ResourceData, remoteNetwork *model.RemoteNetwork, err error) diag.Diagnostics {
+func resourceRemoteNetworkReadHelper(ctx context.Context, c *client.Client, resourceData *schema.ResourceData, remoteNetwork *model.RemoteNetwork, err error) diag.Diagnostics {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if client.IsNotFound(err) {
 			// clear state
 			resourceData.SetId("")
 
 			return nil
 		}
 
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
 	if err := resourceData.Set(attr.Name, remoteNetwork.Name); err != nil {
@@ -116,6 +125,15 @@ func resourceRemoteNetworkReadHelper(resourceData *schema.ResourceData, remoteNe
 		return ErrAttributeSet(err, attr.Location)
 	}
 
+	connectors, err := c.ReadRemoteNetworkConnectors(ctx, remoteNetwork.ID)
+	if err != nil {
+		return ErrDiagnostics(err)
+	}
+
+	if err := resourceData.Set(attr.HealthyConnectors, model.CountAliveConnectors(connectors)); err != nil {
+		return ErrAttributeSet(err, attr.HealthyConnectors)
+	}
+
 	resourceData.SetId(remoteNetwork.ID)
 
 	return nil
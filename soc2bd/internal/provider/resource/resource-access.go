@@ -0,0 +1,190 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/utils"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceAccess() *schema.Resource {
+	principals := []string{attr.GroupID, attr.ServiceAccountID}
+
+	return withLogging(Soc2bdResourceAccess, &schema.Resource{
+		Description: "Resource access grants a single Group or Service Account access to a Resource, so access can be managed apart from the `soc2bd_resource` itself, e.g. by the team owning the Group. " +
+			"Use it with Resources which don't manage their access: a `soc2bd_resource` with `is_authoritative` set to `true` removes the access it doesn't list.",
+		CreateContext: resourceAccessCreate,
+		ReadContext:   resourceAccessRead,
+		UpdateContext: resourceAccessUpdate,
+		DeleteContext: resourceAccessDelete,
+
+		Schema: map[string]*schema.Schema{
+			attr.ResourceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the Resource",
+			},
+			// optional
+			attr.GroupID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: principals,
+				Description:  "The ID of the Group to grant access to the Resource",
+			},
+			attr.ServiceAccountID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: principals,
+				Description:  "The ID of the Service Account to grant access to the Resource",
+			},
+			attr.DeletionProtection: deletionProtectionSchema("Resource Access"),
+			// computed
+			attr.ID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the access grant, in the format `<resource_id>/<principal_id>`",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAccessImport,
+		},
+	})
+}
+
+func resourceAccessCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*provider.Meta).Client
+	resourceID := resourceData.Get(attr.ResourceID).(string)
+	groupID := resourceData.Get(attr.GroupID).(string)
+	serviceAccountID := resourceData.Get(attr.ServiceAccountID).(string)
+
+	var err error
+
+	if groupID != "" {
+		err = c.AddResourceGroups(ctx, &model.Resource{ID: resourceID, Groups: []string{groupID}})
+	} else {
+		err = c.AddResourceServiceAccountIDs(ctx, &model.Resource{ID: resourceID, ServiceAccounts: []string{serviceAccountID}})
+	}
+
+	if err != nil {
+		return ErrDiagnostics(err)
+	}
+
+	principalID := groupID + serviceAccountID
+
+	tflog.SubsystemInfo(ctx, Soc2bdResourceAccess, "Granted access to resource", map[string]interface{}{attr.ResourceID: resourceID, attr.PrincipalID: principalID})
+
+	resourceData.SetId(compositeID(resourceID, principalID))
+
+	return resourceAccessRead(ctx, resourceData, meta)
+}
+
+func resourceAccessRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*provider.Meta).Client
+
+	resourceID, principalID, err := parseResourceAccessID(resourceData.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resource, err := c.ReadResource(ctx, resourceID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			// clear state
+			resourceData.SetId("")
+
+			return nil
+		}
+
+		return ErrDiagnostics(err)
+	}
+
+	// the principal type is looked up, so imported grants only need the IDs
+	var groupID, serviceAccountID string
+
+	switch {
+	case utils.Contains(resource.Groups, principalID):
+		groupID = principalID
+	case utils.Contains(resource.ServiceAccounts, principalID):
+		serviceAccountID = principalID
+	default:
+		tflog.SubsystemWarn(ctx, Soc2bdResourceAccess, "Resource access was removed", map[string]interface{}{attr.ResourceID: resourceID, attr.PrincipalID: principalID})
+
+		// clear state
+		resourceData.SetId("")
+
+		return nil
+	}
+
+	if err := resourceData.Set(attr.ResourceID, resourceID); err != nil {
+		return ErrAttributeSet(err, attr.ResourceID)
+	}
+
+	if err := resourceData.Set(attr.GroupID, groupID); err != nil {
+		return ErrAttributeSet(err, attr.GroupID)
+	}
+
+	if err := resourceData.Set(attr.ServiceAccountID, serviceAccountID); err != nil {
+		return ErrAttributeSet(err, attr.ServiceAccountID)
+	}
+
+	return nil
+}
+
+func resourceAccessUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// only the deletion protection can be updated in place
+	return resourceAccessRead(ctx, resourceData, meta)
+}
+
+func resourceAccessDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*provider.Meta).Client
+
+	if diags := checkDeletionProtection(meta, Soc2bdResourceAccess, resourceData); diags.HasError() {
+		return diags
+	}
+
+	resourceID, principalID, err := parseResourceAccessID(resourceData.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if resourceData.Get(attr.GroupID).(string) != "" {
+		err = c.DeleteResourceGroups(ctx, resourceID, []string{principalID})
+	} else {
+		err = c.DeleteResourceServiceAccounts(ctx, resourceID, []string{principalID})
+	}
+
+	if err != nil && !client.IsNotFound(err) {
+		return ErrDiagnostics(err)
+	}
+
+	tflog.SubsystemInfo(ctx, Soc2bdResourceAccess, "Revoked access to resource", map[string]interface{}{attr.ResourceID: resourceID, attr.PrincipalID: principalID})
+
+	return nil
+}
+
+func resourceAccessImport(_ context.Context, resourceData *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseResourceAccessID(resourceData.Id()); err != nil {
+		return nil, err
+	}
+
+	// the attributes are set when the imported grant is read
+	return []*schema.ResourceData{resourceData}, nil
+}
+
+func parseResourceAccessID(id string) (resourceID, principalID string, err error) {
+	ids, err := parseCompositeID(id, attr.ResourceID, attr.PrincipalID)
+	if err != nil {
+		return "", "", err
+	}
+
+	return ids[0], ids[1], nil
+}
@@ -2,386 +2,682 @@ package resource
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
 	"reflect"
 	"strings"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func Resource() *schema.Resource { //nolint:funlen
-	portsSchema := &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			attr.Policy: {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice(model.Policies, false),
-				Description:  fmt.Sprintf("Whether to allow or deny all ports, or restrict protocol access within certain port ranges: Can be `%s` (only listed ports are allowed), `%s`, or `%s`", model.PolicyRestricted, model.PolicyAllowAll, model.PolicyDenyAll),
-			},
-			attr.Ports: {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "List of port ranges between 1 and 65535 inclusive, in the format `100-200` for a range, or `8080` for a single port",
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-				DiffSuppressOnRefresh: true,
-				DiffSuppressFunc:      portsNotChanged,
-			},
-		},
+// resourceSchemaVersion 1 drops the default protocols the SDK implementation stored in state,
+// see upgradeResourceStateV0.
+const resourceSchemaVersion = 1
+
+var (
+	_ resource.Resource                 = &soc2bdResource{}
+	_ resource.ResourceWithConfigure    = &soc2bdResource{}
+	_ resource.ResourceWithImportState  = &soc2bdResource{}
+	_ resource.ResourceWithUpgradeState = &soc2bdResource{}
+)
+
+// soc2bdResource is the soc2bd_resource implementation on the plugin framework.
+type soc2bdResource struct {
+	client             *client.Client
+	deletionProtection string
+}
+
+func NewResourceResource() resource.Resource {
+	return &soc2bdResource{}
+}
+
+type resourceModel struct {
+	ID                       types.String     `tfsdk:"id"`
+	Name                     types.String     `tfsdk:"name"`
+	Address                  types.String     `tfsdk:"address"`
+	AddressType              types.String     `tfsdk:"address_type"`
+	RemoteNetworkID          types.String     `tfsdk:"remote_network_id"`
+	IsAuthoritative          types.Bool       `tfsdk:"is_authoritative"`
+	Protocols                []protocolsModel `tfsdk:"protocols"`
+	Access                   []accessModel    `tfsdk:"access"`
+	IsVisible                types.Bool       `tfsdk:"is_visible"`
+	IsBrowserShortcutEnabled types.Bool       `tfsdk:"is_browser_shortcut_enabled"`
+	Alias                    types.String     `tfsdk:"alias"`
+	IsActive                 types.Bool       `tfsdk:"is_active"`
+	DeactivateOnDestroy      types.Bool       `tfsdk:"deactivate_on_destroy"`
+	DeletionProtection       types.Bool       `tfsdk:"deletion_protection"`
+}
+
+type protocolsModel struct {
+	AllowIcmp types.Bool      `tfsdk:"allow_icmp"`
+	TCP       []protocolModel `tfsdk:"tcp"`
+	UDP       []protocolModel `tfsdk:"udp"`
+}
+
+type protocolModel struct {
+	Policy types.String `tfsdk:"policy"`
+	Ports  types.List   `tfsdk:"ports"`
+}
+
+type accessModel struct {
+	GroupIDs          types.Set `tfsdk:"group_ids"`
+	ServiceAccountIDs types.Set `tfsdk:"service_account_ids"`
+}
+
+func (r *soc2bdResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = Soc2bdResource
+}
+
+func (r *soc2bdResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
 	}
 
-	protocolsSchema := &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			attr.AllowIcmp: {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     true,
-				Description: "Whether to allow ICMP (ping) traffic",
-			},
-			attr.TCP: {
-				Type:                  schema.TypeList,
-				Required:              true,
-				MaxItems:              1,
-				Elem:                  portsSchema,
-				DiffSuppressOnRefresh: true,
-				DiffSuppressFunc:      protocolDiff,
-			},
-			attr.UDP: {
-				Type:                  schema.TypeList,
-				Required:              true,
-				MaxItems:              1,
-				Elem:                  portsSchema,
-				DiffSuppressOnRefresh: true,
-				DiffSuppressFunc:      protocolDiff,
-			},
-		},
+	meta, ok := req.ProviderData.(*provider.Meta)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *provider.Meta, got %T", req.ProviderData))
+
+		return
 	}
 
-	accessSchema := &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			attr.GroupIDs: {
-				Type:         schema.TypeSet,
-				Elem:         &schema.Schema{Type: schema.TypeString},
-				MinItems:     1,
-				Optional:     true,
-				AtLeastOneOf: []string{attr.Path(attr.Access, attr.ServiceAccountIDs)},
-				Description:  "List of Group IDs that will have permission to access the Resource.",
+	r.client = meta.Client
+	r.deletionProtection = meta.DeletionProtection
+}
+
+func (r *soc2bdResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) { //nolint:funlen
+	portsBlock := schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			attr.Policy: schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(model.Policies...), restrictedPolicyValidator{}},
+				Description: fmt.Sprintf("Whether to allow or deny all ports, or restrict protocol access within certain port ranges: Can be `%s` (only listed ports are allowed), `%s`, or `%s`", model.PolicyRestricted, model.PolicyAllowAll, model.PolicyDenyAll),
 			},
-			attr.ServiceAccountIDs: {
-				Type:         schema.TypeSet,
-				Elem:         &schema.Schema{Type: schema.TypeString},
-				MinItems:     1,
-				Optional:     true,
-				AtLeastOneOf: []string{attr.Path(attr.Access, attr.GroupIDs)},
-				Description:  "List of Service Account IDs that will have permission to access the Resource.",
+			attr.Ports: schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				// computed to keep the ports as configured when they only differ in their format
+				Computed:      true,
+				PlanModifiers: []planmodifier.List{portsPlanModifier{}},
+				Validators:    []validator.List{mergedPortsValidator{}},
+				Description:   "List of port ranges between 1 and 65535 inclusive, in the format `100-200` for a range, or `8080` for a single port. Overlapping and adjacent ranges are merged.",
 			},
 		},
 	}
 
-	return &schema.Resource{
-		Description:   "Resources in Soc2bd represent servers on the private network that clients can connect to. Resources can be defined by IP, CIDR range, FQDN, or DNS zone. For more information, see the Soc2bd [documentation](https://docs.soc2bd.com/docs/resources-and-access-nodes).",
-		CreateContext: resourceCreate,
-		UpdateContext: resourceUpdate,
-		ReadContext:   resourceRead,
-		DeleteContext: resourceDelete,
+	protocolBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			NestedObject: portsBlock,
+			Validators:   []validator.List{listvalidator.IsRequired(), listvalidator.SizeAtMost(1)},
+		}
+	}
 
-		Schema: map[string]*schema.Schema{
+	resp.Schema = schema.Schema{
+		Version:     resourceSchemaVersion,
+		Description: "Resources in Soc2bd represent servers on the private network that clients can connect to. Resources can be defined by IP, CIDR range, FQDN, or DNS zone. For more information, see the Soc2bd [documentation](https://docs.soc2bd.com/docs/resources-and-access-nodes).",
+		Attributes: map[string]schema.Attribute{
 			// required
-			attr.Name: {
-				Type:        schema.TypeString,
+			attr.Name: schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the Resource",
 			},
-			attr.Address: {
-				Type:        schema.TypeString,
+			attr.Address: schema.StringAttribute{
 				Required:    true,
+				Validators:  []validator.String{addressValidator{}},
 				Description: "The Resource's IP/CIDR or FQDN/DNS zone",
 			},
-			attr.RemoteNetworkID: {
-				Type:        schema.TypeString,
+			attr.RemoteNetworkID: schema.StringAttribute{
 				Required:    true,
 				Description: "Remote Network ID where the Resource lives",
 			},
 			// optional
-			attr.IsAuthoritative: {
-				Type:        schema.TypeBool,
+			attr.IsAuthoritative: schema.BoolAttribute{
 				Optional:    true,
 				Computed:    true,
+				Default:     booldefault.StaticBool(true),
 				Description: "Determines whether assignments in the access block will override any existing assignments. Default is `true`. If set to `false`, assignments made outside of Terraform will be ignored.",
 			},
-			attr.Protocols: {
-				Type:                  schema.TypeList,
-				Optional:              true,
-				MaxItems:              1,
-				Description:           "Restrict access to certain protocols and ports. By default or when this argument is not defined, there is no restriction, and all protocols and ports are allowed.",
-				DiffSuppressOnRefresh: true,
-				DiffSuppressFunc:      protocolsDiff,
-				Elem:                  protocolsSchema,
-			},
-			attr.Access: {
-				Type:        schema.TypeList,
+			attr.Alias: schema.StringAttribute{
 				Optional:    true,
-				MaxItems:    1,
-				Description: "Restrict access to certain groups or service accounts",
-				Elem:        accessSchema,
+				Validators:  []validator.String{aliasValidator{}},
+				Description: "Set a DNS alias address for the Resource. Must be a DNS-valid name string.",
 			},
-			// computed
-			attr.IsVisible: {
-				Type:        schema.TypeBool,
+			attr.IsActive: schema.BoolAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "Controls whether this Resource will be visible in the main Resource list in the Soc2bd Client.",
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the Resource is active. Default is `true`. A Resource deactivated outside of Terraform shows as a change in plan, it's not reactivated on refresh.",
 			},
-			attr.IsBrowserShortcutEnabled: {
-				Type:        schema.TypeBool,
+			attr.DeactivateOnDestroy: schema.BoolAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: `Controls whether an "Open in Browser" shortcut will be shown for this Resource in the Soc2bd Client.`,
+				Default:     booldefault.StaticBool(false),
+				Description: "Deactivate the Resource instead of deleting it on destroy, which keeps its audit history. Default is `false`.",
 			},
-			attr.Alias: {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Description:      "Set a DNS alias address for the Resource. Must be a DNS-valid name string.",
-				DiffSuppressFunc: aliasDiff,
+			attr.DeletionProtection: schema.BoolAttribute{
+				Optional:    true,
+				Description: deletionProtectionSchema("Resource").Description,
 			},
-			attr.ID: {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Autogenerated ID of the Resource, encoded in base64",
+			// computed
+			attr.IsVisible: schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+				Description:   "Controls whether this Resource will be visible in the main Resource list in the Soc2bd Client.",
+			},
+			attr.IsBrowserShortcutEnabled: schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+				Description:   `Controls whether an "Open in Browser" shortcut will be shown for this Resource in the Soc2bd Client.`,
+			},
+			attr.AddressType: schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{addressTypePlanModifier{}},
+				Description:   fmt.Sprintf("The type of the Resource's address: %s.", strings.Join(model.AddressTypes, ", ")),
+			},
+			attr.ID: schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "Autogenerated ID of the Resource, encoded in base64",
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+		Blocks: map[string]schema.Block{
+			attr.Protocols: schema.ListNestedBlock{
+				Validators:  []validator.List{listvalidator.SizeAtMost(1)},
+				Description: "Restrict access to certain protocols and ports. By default or when this argument is not defined, there is no restriction, and all protocols and ports are allowed.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						attr.AllowIcmp: schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(true),
+							Description: "Whether to allow ICMP (ping) traffic",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						attr.TCP: protocolBlock(),
+						attr.UDP: protocolBlock(),
+					},
+				},
+			},
+			attr.Access: schema.ListNestedBlock{
+				Validators:  []validator.List{listvalidator.SizeAtMost(1)},
+				Description: "Restrict access to certain groups or service accounts",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						attr.GroupIDs: schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							// computed to tell apart empty and null sets, which have the same meaning
+							Computed:      true,
+							PlanModifiers: []planmodifier.Set{emptySetPlanModifier{}},
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName(attr.ServiceAccountIDs)),
+							},
+							Description: "List of Group IDs that will have permission to access the Resource.",
+						},
+						attr.ServiceAccountIDs: schema.SetAttribute{
+							ElementType:   types.StringType,
+							Optional:      true,
+							Computed:      true,
+							PlanModifiers: []planmodifier.Set{emptySetPlanModifier{}},
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName(attr.GroupIDs)),
+							},
+							Description: "List of Service Account IDs that will have permission to access the Resource.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
-func resourceCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*client.Client)
+func (r *soc2bdResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceModel
 
-	resource, err := convertResource(resourceData)
-	if err != nil {
-		return diag.FromErr(err)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	input, diags := convertResource(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	resource, err = client.CreateResource(ctx, resource)
+	ctx = logContext(ctx, r.client, Soc2bdResource, operationCreate, "")
+
+	resource, err := r.client.CreateResource(ctx, input)
 	if err != nil {
-		return diag.FromErr(err)
+		addErrDiagnostics(&resp.Diagnostics, err)
+
+		return
 	}
 
-	if err = client.AddResourceServiceAccountIDs(ctx, resource); err != nil {
-		return diag.FromErr(err)
+	if err = r.client.AddResourceServiceAccountIDs(ctx, resource); err != nil {
+		addErrDiagnostics(&resp.Diagnostics, err)
+
+		return
+	}
+
+	// resources are created active
+	if !input.IsActive {
+		if err = r.client.UpdateResourceActiveState(ctx, &model.Resource{ID: resource.ID, IsActive: false}); err != nil {
+			addErrDiagnostics(&resp.Diagnostics, err)
+
+			return
+		}
 	}
 
-	log.Printf("[INFO] Created resource %s", resource.Name)
+	tflog.SubsystemInfo(ctx, Soc2bdResource, "Created resource", map[string]interface{}{
+		client.LogFieldEntityID: resource.ID,
+		attr.Name:               resource.Name,
+	})
 
-	return resourceResourceReadHelper(ctx, client, resourceData, resource, nil)
+	r.readAfterApply(ctx, resource.ID, plan, &resp.State, &resp.Diagnostics)
 }
 
-func resourceUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*client.Client)
+func (r *soc2bdResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = logContext(ctx, r.client, Soc2bdResource, operationRead, state.ID.ValueString())
 
-	resource, err := convertResource(resourceData)
+	resource, err := r.client.ReadResource(ctx, state.ID.ValueString())
 	if err != nil {
-		return diag.FromErr(err)
+		if client.IsNotFound(err) {
+			// clear state
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		addErrDiagnostics(&resp.Diagnostics, err)
+
+		return
 	}
 
-	resource.ID = resourceData.Id()
+	newState, diags := resourceState(ctx, state, resource)
+	resp.Diagnostics.Append(diags...)
 
-	if err = deleteResourceGroupIDs(ctx, resourceData, resource, client); err != nil {
-		return diag.FromErr(err)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if err = deleteResourceServiceAccountIDs(ctx, resourceData, resource, client); err != nil {
-		return diag.FromErr(err)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *soc2bdResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	input, diags := convertResource(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if err = client.AddResourceServiceAccountIDs(ctx, resource); err != nil {
-		return diag.FromErr(err)
+	input.ID = state.ID.ValueString()
+	ctx = logContext(ctx, r.client, Soc2bdResource, operationUpdate, input.ID)
+
+	if err := r.deleteRemovedAccess(ctx, input, state); err != nil {
+		addErrDiagnostics(&resp.Diagnostics, err)
+
+		return
 	}
 
-	resource, err = client.UpdateResource(ctx, resource)
+	if err := r.client.AddResourceServiceAccountIDs(ctx, input); err != nil {
+		addErrDiagnostics(&resp.Diagnostics, err)
+
+		return
+	}
+
+	resource, err := r.client.UpdateResource(ctx, input)
 	if err != nil {
-		return diag.FromErr(err)
+		addErrDiagnostics(&resp.Diagnostics, err)
+
+		return
+	}
+
+	if !plan.IsActive.Equal(state.IsActive) {
+		if err = r.client.UpdateResourceActiveState(ctx, &model.Resource{ID: resource.ID, IsActive: input.IsActive}); err != nil {
+			addErrDiagnostics(&resp.Diagnostics, err)
+
+			return
+		}
 	}
 
-	log.Printf("[INFO] Updated resource %s", resource.Name)
+	tflog.SubsystemInfo(ctx, Soc2bdResource, "Updated resource", map[string]interface{}{attr.Name: resource.Name})
 
-	return resourceResourceReadHelper(ctx, client, resourceData, resource, nil)
+	r.readAfterApply(ctx, resource.ID, plan, &resp.State, &resp.Diagnostics)
 }
 
-func resourceRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*client.Client)
+func (r *soc2bdResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
-	resource, err := client.ReadResource(ctx, resourceData.Id())
-	if resource != nil {
-		resource.IsAuthoritative = convertAuthoritativeFlag(resourceData)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return resourceResourceReadHelper(ctx, client, resourceData, resource, err)
-}
+	ctx = logContext(ctx, r.client, Soc2bdResource, operationDelete, state.ID.ValueString())
 
-func resourceDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
-	resourceID := resourceData.Id()
+	if isDeletionProtected(state.DeletionProtection.ValueBoolPointer(), r.deletionProtection) {
+		for _, d := range deletionProtectedDiagnostics(Soc2bdResource, state.ID.ValueString()) {
+			resp.Diagnostics.AddError(d.Summary, d.Detail)
+		}
 
-	err := c.DeleteResource(ctx, resourceID)
-	if err != nil {
-		return diag.FromErr(err)
+		return
 	}
 
-	log.Printf("[INFO] Deleted resource id %s", resourceData.Id())
+	if state.DeactivateOnDestroy.ValueBool() {
+		err := r.client.UpdateResourceActiveState(ctx, &model.Resource{ID: state.ID.ValueString(), IsActive: false})
+		if err != nil && !client.IsNotFound(err) {
+			addErrDiagnostics(&resp.Diagnostics, err)
 
-	return nil
+			return
+		}
+
+		tflog.SubsystemInfo(ctx, Soc2bdResource, "Deactivated resource instead of deleting it")
+
+		return
+	}
+
+	if err := r.client.DeleteResource(ctx, state.ID.ValueString()); err != nil {
+		addErrDiagnostics(&resp.Diagnostics, err)
+
+		return
+	}
+
+	tflog.SubsystemInfo(ctx, Soc2bdResource, "Deleted resource")
 }
 
-func resourceResourceReadHelper(ctx context.Context, resourceClient *client.Client, resourceData *schema.ResourceData, resource *model.Resource, err error) diag.Diagnostics {
+func (r *soc2bdResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := resolveImportID(ctx, r.client, req.ID, resolveResourceImport,
+		[]string{importKeyName}, []string{importKeyNetwork, importKeyAddress}, []string{importKeyNetwork, importKeyName})
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
-			// clear state
-			resourceData.SetId("")
+		resp.Diagnostics.AddError("Failed to import resource", err.Error())
 
-			return nil
-		}
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.ID), id)...)
+}
+
+func (r *soc2bdResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var schemaResp resource.SchemaResponse
 
-		return diag.FromErr(err)
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	return map[int64]resource.StateUpgrader{
+		// the attribute types didn't change, only the stored values
+		0: {
+			PriorSchema:   &schemaResp.Schema,
+			StateUpgrader: upgradeResourceStateV0,
+		},
 	}
+}
+
+// upgradeResourceStateV0 converts the state written by the SDK implementation: it always stored the protocols,
+// even when they were not configured, and stored `DENY_ALL` policies as `RESTRICTED` without ports.
+func upgradeResourceStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var state resourceModel
 
-	if resource.Protocols == nil {
-		resource.Protocols = model.DefaultProtocols()
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !resource.IsActive {
-		// fix set active state for the resource on `terraform apply`
-		err = resourceClient.UpdateResourceActiveState(ctx, &model.Resource{
-			ID:       resource.ID,
-			IsActive: true,
-		})
+	if len(state.Protocols) > 0 {
+		protocols, diags := convertProtocols(ctx, state.Protocols)
+		resp.Diagnostics.Append(diags...)
 
-		if err != nil {
-			return diag.FromErr(err)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-	}
 
-	if !resource.IsAuthoritative {
-		groups, serviceAccounts := convertAccess(resourceData)
-		resource.ServiceAccounts = setIntersection(serviceAccounts, resource.ServiceAccounts)
-		resource.Groups = setIntersection(groups, resource.Groups)
+		if equalProtocols(protocols, model.DefaultProtocols()) {
+			state.Protocols = nil
+		} else {
+			upgradeDenyAllPolicy(state.Protocols[0].TCP)
+			upgradeDenyAllPolicy(state.Protocols[0].UDP)
+		}
 	}
 
-	resourceData.SetId(resource.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
 
-	return readDiagnostics(resourceData, resource)
+func upgradeDenyAllPolicy(protocols []protocolModel) {
+	for i := range protocols {
+		if protocols[i].Policy.ValueString() == model.PolicyRestricted && len(protocols[i].Ports.Elements()) == 0 {
+			protocols[i].Policy = types.StringValue(model.PolicyDenyAll)
+		}
+	}
 }
 
-func readDiagnostics(resourceData *schema.ResourceData, resource *model.Resource) diag.Diagnostics { //nolint:cyclop
-	if err := resourceData.Set(attr.Name, resource.Name); err != nil {
-		return ErrAttributeSet(err, attr.Name)
+// readAfterApply reads the resource back, the API doesn't return all the computed values on create and update.
+func (r *soc2bdResource) readAfterApply(ctx context.Context, resourceID string, plan resourceModel, state stateSetter, diagnostics *diag.Diagnostics) {
+	resource, err := r.client.ReadResource(ctx, resourceID)
+	if err != nil {
+		addErrDiagnostics(diagnostics, err)
+
+		return
 	}
 
-	if err := resourceData.Set(attr.RemoteNetworkID, resource.RemoteNetworkID); err != nil {
-		return ErrAttributeSet(err, attr.RemoteNetworkID)
+	newState, diags := resourceState(ctx, plan, resource)
+	diagnostics.Append(diags...)
+
+	if diagnostics.HasError() {
+		return
 	}
 
-	if err := resourceData.Set(attr.Address, resource.Address); err != nil {
-		return ErrAttributeSet(err, attr.Address)
+	diagnostics.Append(state.Set(ctx, newState)...)
+}
+
+type stateSetter interface {
+	Set(ctx context.Context, val interface{}) diag.Diagnostics
+}
+
+// deleteRemovedAccess removes the groups and service accounts that are not in the plan anymore.
+// For authoritative resources these are all the ones assigned in Soc2bd, otherwise only the ones removed from the configuration.
+func (r *soc2bdResource) deleteRemovedAccess(ctx context.Context, input *model.Resource, state resourceModel) error {
+	var oldGroups, oldServiceAccounts []string
+
+	if input.IsAuthoritative {
+		resource, err := r.client.ReadResource(ctx, input.ID)
+		if err == nil {
+			oldGroups, oldServiceAccounts = resource.Groups, resource.ServiceAccounts
+		}
+	} else {
+		var diags diag.Diagnostics
+
+		oldGroups, oldServiceAccounts, diags = convertAccess(ctx, state.Access)
+		if diags.HasError() {
+			return fmt.Errorf("failed to read the access in state: %v", diags) //nolint:goerr113
+		}
 	}
 
-	if err := resourceData.Set(attr.IsAuthoritative, resource.IsAuthoritative); err != nil {
-		return ErrAttributeSet(err, attr.IsAuthoritative)
+	if err := r.client.DeleteResourceGroups(ctx, input.ID, setDifference(oldGroups, input.Groups)); err != nil {
+		return err //nolint:wrapcheck
 	}
 
-	if err := resourceData.Set(attr.Access, resource.AccessToTerraform()); err != nil {
-		return ErrAttributeSet(err, attr.Access)
+	return r.client.DeleteResourceServiceAccounts(ctx, input.ID, setDifference(oldServiceAccounts, input.ServiceAccounts)) //nolint:wrapcheck
+}
+
+// resourceState converts the resource read from the API to its state, the prior state or plan is used
+// to keep the values as they are configured when they are only formatted differently.
+func resourceState(ctx context.Context, prior resourceModel, resource *model.Resource) (resourceModel, diag.Diagnostics) { //nolint:cyclop
+	var diags diag.Diagnostics
+
+	state := resourceModel{
+		ID:                       types.StringValue(resource.ID),
+		Name:                     types.StringValue(resource.Name),
+		Address:                  types.StringValue(resource.Address),
+		AddressType:              addressTypeState(resource.Address),
+		RemoteNetworkID:          types.StringValue(resource.RemoteNetworkID),
+		IsAuthoritative:          types.BoolValue(true),
+		Alias:                    types.StringPointerValue(resource.Alias),
+		IsVisible:                types.BoolPointerValue(resource.IsVisible),
+		IsBrowserShortcutEnabled: types.BoolPointerValue(resource.IsBrowserShortcutEnabled),
+		IsActive:                 types.BoolValue(resource.IsActive),
+		DeactivateOnDestroy:      types.BoolValue(false),
+		// not stored in Soc2bd, only in state
+		DeletionProtection: prior.DeletionProtection,
 	}
 
-	if err := resourceData.Set(attr.Protocols, resource.Protocols.ToTerraform()); err != nil {
-		return ErrAttributeSet(err, attr.Protocols)
+	if !prior.IsAuthoritative.IsNull() && !prior.IsAuthoritative.IsUnknown() {
+		state.IsAuthoritative = prior.IsAuthoritative
 	}
 
-	if resource.IsVisible != nil {
-		if err := resourceData.Set(attr.IsVisible, *resource.IsVisible); err != nil {
-			return ErrAttributeSet(err, attr.IsVisible)
-		}
+	// not stored in Soc2bd, only in state
+	if !prior.DeactivateOnDestroy.IsNull() && !prior.DeactivateOnDestroy.IsUnknown() {
+		state.DeactivateOnDestroy = prior.DeactivateOnDestroy
 	}
 
-	if resource.IsBrowserShortcutEnabled != nil {
-		if err := resourceData.Set(attr.IsBrowserShortcutEnabled, *resource.IsBrowserShortcutEnabled); err != nil {
-			return ErrAttributeSet(err, attr.IsBrowserShortcutEnabled)
+	groups, serviceAccounts := resource.Groups, resource.ServiceAccounts
+
+	if !state.IsAuthoritative.ValueBool() {
+		// only the assignments managed by terraform are tracked
+		priorGroups, priorServiceAccounts, priorDiags := convertAccess(ctx, prior.Access)
+		diags.Append(priorDiags...)
+
+		groups = setIntersection(priorGroups, groups)
+		serviceAccounts = setIntersection(priorServiceAccounts, serviceAccounts)
+	}
+
+	if len(groups) > 0 || len(serviceAccounts) > 0 {
+		var priorAccess accessModel
+		if len(prior.Access) > 0 {
+			priorAccess = prior.Access[0]
 		}
+
+		state.Access = []accessModel{{
+			GroupIDs:          idsState(priorAccess.GroupIDs, groups),
+			ServiceAccountIDs: idsState(priorAccess.ServiceAccountIDs, serviceAccounts),
+		}}
 	}
 
-	var alias interface{}
-	if resource.Alias != nil {
-		alias = *resource.Alias
+	protocols := resource.Protocols
+	if protocols == nil {
+		protocols = model.DefaultProtocols()
 	}
 
-	if err := resourceData.Set(attr.Alias, alias); err != nil {
-		return ErrAttributeSet(err, attr.Alias)
+	// protocols are not stored when they are not configured and left to their default
+	if len(prior.Protocols) > 0 || !equalProtocols(protocols, model.DefaultProtocols()) {
+		var priorProtocols protocolsModel
+		if len(prior.Protocols) > 0 {
+			priorProtocols = prior.Protocols[0]
+		}
+
+		state.Protocols = []protocolsModel{{
+			AllowIcmp: types.BoolValue(protocols.AllowIcmp),
+			TCP:       protocolState(priorProtocols.TCP, protocols.TCP),
+			UDP:       protocolState(priorProtocols.UDP, protocols.UDP),
+		}}
 	}
 
-	return nil
+	return state, diags
 }
 
-func protocolDiff(attribute, oldValue, newValue string, data *schema.ResourceData) bool {
-	keys := []string{
-		attr.Path(attr.Protocols, attr.TCP, attr.Policy),
-		attr.Path(attr.Protocols, attr.UDP, attr.Policy),
+// addressTypeState classifies the address read from the API, it's null when the address isn't valid.
+func addressTypeState(address string) types.String {
+	addressType, err := model.ClassifyAddress(address)
+	if err != nil {
+		return types.StringNull()
 	}
 
-	for _, key := range keys {
-		if strings.HasPrefix(attribute, key) {
-			oldPolicy, newPolicy := castToStrings(data.GetChange(key))
-			if oldPolicy == model.PolicyRestricted && newPolicy == model.PolicyDenyAll {
-				return true
-			}
+	return types.StringValue(addressType)
+}
+
+func idsState(prior types.Set, ids []string) types.Set {
+	if len(ids) == 0 {
+		if !prior.IsUnknown() && !prior.IsNull() && len(prior.Elements()) == 0 {
+			return prior
 		}
+
+		return types.SetNull(types.StringType)
 	}
 
-	return false
+	return types.SetValueMust(types.StringType, stringValues(ids))
 }
 
-func protocolsDiff(key, oldValue, newValue string, resourceData *schema.ResourceData) bool {
-	switch key {
-	case attr.Len(attr.Protocols),
-		attr.Len(attr.Protocols, attr.TCP),
-		attr.Len(attr.Protocols, attr.UDP):
-		return oldValue == "1" && newValue == "0"
+func protocolState(prior []protocolModel, protocol *model.Protocol) []protocolModel {
+	if protocol == nil {
+		protocol = model.DefaultProtocol()
+	}
 
-	case attr.Path(attr.Protocols, attr.TCP, attr.Policy),
-		attr.Path(attr.Protocols, attr.UDP, attr.Policy):
-		oldPolicy, newPolicy := castToStrings(resourceData.GetChange(key))
+	state := protocolModel{
+		Policy: types.StringValue(protocol.Policy),
+		Ports:  types.ListNull(types.StringType),
+	}
 
-		return oldPolicy == newPolicy
+	if ports := protocol.PortsToString(); len(ports) > 0 {
+		state.Ports = types.ListValueMust(types.StringType, stringValues(ports))
+	}
 
-	default:
-		return false
+	if len(prior) == 0 {
+		return []protocolModel{state}
 	}
-}
 
-func aliasDiff(key, _, _ string, resourceData *schema.ResourceData) bool {
-	oldVal, newVal := castToStrings(resourceData.GetChange(key))
+	// DENY_ALL is stored as RESTRICTED without ports
+	if prior[0].Policy.ValueString() == model.PolicyDenyAll && protocol.Policy == model.PolicyRestricted && len(protocol.Ports) == 0 {
+		state.Policy = prior[0].Policy
+	}
 
-	return oldVal == newVal
+	if !prior[0].Ports.IsUnknown() && equalPorts(listStrings(prior[0].Ports), protocol.PortsToString()) {
+		state.Ports = prior[0].Ports
+	}
+
+	return []protocolModel{state}
+}
+
+func equalProtocols(a, b *model.Protocols) bool {
+	return a.AllowIcmp == b.AllowIcmp && equalProtocol(a.TCP, b.TCP) && equalProtocol(a.UDP, b.UDP)
 }
 
-func equalPorts(a, b interface{}) bool {
-	oldPorts, newPorts := a.([]interface{}), b.([]interface{})
+func equalProtocol(a, b *model.Protocol) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Policy == b.Policy && equalPorts(a.PortsToString(), b.PortsToString())
+}
 
-	oldPortsRange, err := convertPorts(oldPorts)
+func equalPorts(a, b []string) bool {
+	oldPortsRange, err := convertPorts(a)
 	if err != nil {
 		return false
 	}
 
-	newPortsRange, err := convertPorts(newPorts)
+	newPortsRange, err := convertPorts(b)
 	if err != nil {
 		return false
 	}
@@ -410,201 +706,296 @@ func convertPortsRangeToMap(portsRange []*model.PortRange) map[int]struct{} {
 	return out
 }
 
-func portsNotChanged(attribute, oldValue, newValue string, data *schema.ResourceData) bool {
-	keys := []string{
-		attr.Path(attr.Protocols, attr.TCP, attr.Ports),
-		attr.Path(attr.Protocols, attr.UDP, attr.Ports),
+// portsPlanModifier keeps the ports in state when the configured ones only differ in their format,
+// e.g. `80-81` and `80`, `81`.
+type portsPlanModifier struct{}
+
+func (m portsPlanModifier) Description(_ context.Context) string {
+	return "Keeps the ports in state when the configured ones allow the same ports."
+}
+
+func (m portsPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m portsPlanModifier) PlanModifyList(_ context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if req.ConfigValue.IsUnknown() {
+		return
 	}
 
-	for _, key := range keys {
-		if strings.HasPrefix(attribute, key) {
-			return equalPorts(data.GetChange(key))
-		}
+	if !req.StateValue.IsNull() && !req.StateValue.IsUnknown() && equalPorts(listStrings(req.StateValue), listStrings(req.ConfigValue)) {
+		resp.PlanValue = req.StateValue
+
+		return
 	}
 
-	return false
+	resp.PlanValue = req.ConfigValue
 }
 
-func deleteResourceGroupIDs(ctx context.Context, resourceData *schema.ResourceData, resource *model.Resource, client *client.Client) error {
-	groupIDs := getIDsToDelete(ctx, resourceData, resource.Groups, attr.GroupIDs, resource, client)
+// mergedPortsValidator warns when overlapping or adjacent ports are merged before they are sent to the API.
+type mergedPortsValidator struct{}
 
-	return client.DeleteResourceGroups(ctx, resource.ID, groupIDs) //nolint
+func (v mergedPortsValidator) Description(_ context.Context) string {
+	return "Warns when overlapping or adjacent ports are merged."
 }
 
-func deleteResourceServiceAccountIDs(ctx context.Context, resourceData *schema.ResourceData, resource *model.Resource, client *client.Client) error {
-	idsToDelete := getIDsToDelete(ctx, resourceData, resource.ServiceAccounts, attr.ServiceAccountIDs, resource, client)
-
-	return client.DeleteResourceServiceAccounts(ctx, resource.ID, idsToDelete) //nolint
+func (v mergedPortsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
 }
 
-func getIDsToDelete(ctx context.Context, resourceData *schema.ResourceData, currentIDs []string, attribute string, resource *model.Resource, client *client.Client) []string {
-	oldIDs := getOldIDs(ctx, resourceData, attribute, resource, client)
-	if len(oldIDs) == 0 {
-		return nil
+func (v mergedPortsValidator) ValidateList(_ context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if !isKnownList(req.ConfigValue) {
+		return
 	}
 
-	return setDifference(oldIDs, currentIDs)
-}
+	// invalid ports are reported when the protocols are converted
+	ports, err := convertPorts(listStrings(req.ConfigValue))
+	if err != nil {
+		return
+	}
 
-func getOldIDs(ctx context.Context, resourceData *schema.ResourceData, attribute string, resource *model.Resource, client *client.Client) []string {
-	if resource.IsAuthoritative {
-		return getOldIDsAuthoritative(ctx, resource, client, attribute)
+	merged := model.MergePortRanges(ports)
+	if len(merged) == len(ports) {
+		return
 	}
 
-	return getOldIDsNonAuthoritative(resourceData, attribute)
+	resp.Diagnostics.AddAttributeWarning(req.Path, "Overlapping ports merged",
+		fmt.Sprintf("The ports [%s] overlap or are adjacent, they're applied as [%s].",
+			strings.Join(listStrings(req.ConfigValue), ", "), strings.Join((&model.Protocol{Ports: merged}).PortsToString(), ", ")))
+}
+
+// restrictedPolicyValidator rejects a RESTRICTED policy without any port, which allows no traffic at all.
+type restrictedPolicyValidator struct{}
+
+func (v restrictedPolicyValidator) Description(_ context.Context) string {
+	return "A RESTRICTED policy must allow at least one port."
 }
 
-func getOldIDsNonAuthoritative(resourceData *schema.ResourceData, attribute string) []string {
-	if resourceData.HasChange(attribute) {
-		old, _ := resourceData.GetChange(attribute)
+func (v restrictedPolicyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
 
-		return convertIDs(old)
+func (v restrictedPolicyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.ValueString() != model.PolicyRestricted {
+		return
 	}
 
-	if resourceData.HasChange(attr.Path(attr.Access, attribute)) {
-		old, _ := resourceData.GetChange(attr.Path(attr.Access, attribute))
+	var ports types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName(attr.Ports), &ports)...)
 
-		return convertIDs(old)
+	if resp.Diagnostics.HasError() || !isKnownList(ports) {
+		return
 	}
 
-	return nil
+	if len(ports.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid protocol", model.ErrRestrictedNoPorts.Error())
+	}
 }
 
-func getOldIDsAuthoritative(ctx context.Context, resource *model.Resource, client *client.Client, attribute string) []string {
-	res, err := client.ReadResource(ctx, resource.ID)
-	if err != nil {
-		return nil
+func isKnownList(list types.List) bool {
+	if list.IsUnknown() {
+		return false
 	}
 
-	switch attribute {
-	case attr.ServiceAccountIDs:
-		return res.ServiceAccounts
+	for _, elem := range list.Elements() {
+		if elem.IsUnknown() {
+			return false
+		}
+	}
 
-	case attr.GroupIDs:
-		return res.Groups
+	return true
+}
+
+// addressTypePlanModifier plans the type of the configured address, so it's known before apply.
+type addressTypePlanModifier struct{}
+
+func (m addressTypePlanModifier) Description(_ context.Context) string {
+	return "Plans the type of the configured address."
+}
+
+func (m addressTypePlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m addressTypePlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var address types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(attr.Address), &address)...)
+
+	if resp.Diagnostics.HasError() || address.IsUnknown() || address.IsNull() {
+		return
 	}
 
-	return nil
+	resp.PlanValue = addressTypeState(address.ValueString())
 }
 
-func convertResource(data *schema.ResourceData) (*model.Resource, error) {
-	protocols, err := convertProtocols(data)
-	if err != nil {
-		return nil, err
+// addressValidator rejects addresses that are neither an IP, a CIDR block, an FQDN nor a wildcard DNS zone.
+type addressValidator struct{}
+
+func (v addressValidator) Description(_ context.Context) string {
+	return "Address must be an IP, a CIDR block, an FQDN or a wildcard DNS zone."
+}
+
+func (v addressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v addressValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
 	}
 
-	groups, serviceAccounts := convertAccess(data)
-	res := &model.Resource{
-		Name:            data.Get(attr.Name).(string),
-		RemoteNetworkID: data.Get(attr.RemoteNetworkID).(string),
-		Address:         data.Get(attr.Address).(string),
-		Protocols:       protocols,
-		Groups:          groups,
-		ServiceAccounts: serviceAccounts,
-		IsAuthoritative: convertAuthoritativeFlag(data),
-		Alias:           getOptionalString(data, attr.Alias),
+	if _, err := model.ClassifyAddress(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Resource Address", err.Error())
 	}
+}
+
+// aliasValidator rejects aliases that don't follow the DNS label rules, an empty alias removes it.
+type aliasValidator struct{}
+
+func (v aliasValidator) Description(_ context.Context) string {
+	return "Alias must be a DNS-valid name."
+}
 
-	isVisible, ok := data.GetOkExists(attr.IsVisible) //nolint
-	if val := isVisible.(bool); ok {
-		res.IsVisible = &val
+func (v aliasValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v aliasValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() || req.ConfigValue.ValueString() == "" {
+		return
 	}
 
-	isBrowserShortcutEnabled, ok := data.GetOkExists(attr.IsBrowserShortcutEnabled) //nolint:staticcheck
-	if val := isBrowserShortcutEnabled.(bool); ok {
-		res.IsBrowserShortcutEnabled = &val
+	if err := model.ValidateAlias(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Resource Alias", err.Error())
 	}
+}
 
-	return res, nil
+// emptySetPlanModifier plans the configured value, but keeps the empty set in state when the set is not configured.
+type emptySetPlanModifier struct{}
+
+func (m emptySetPlanModifier) Description(_ context.Context) string {
+	return "Keeps the empty set in state when the set is not configured."
+}
+
+func (m emptySetPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
 }
 
-func getOptionalString(data *schema.ResourceData, attr string) *string {
-	var result *string
+func (m emptySetPlanModifier) PlanModifySet(_ context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
 
-	cfg := data.GetRawConfig()
-	val := cfg.GetAttr(attr)
+	if req.ConfigValue.IsNull() && !req.StateValue.IsUnknown() && len(req.StateValue.Elements()) == 0 {
+		resp.PlanValue = req.StateValue
 
-	if !val.IsNull() {
-		str := val.AsString()
-		result = &str
+		return
 	}
 
-	return result
+	resp.PlanValue = req.ConfigValue
 }
 
-func convertAccess(data *schema.ResourceData) ([]string, []string) {
-	rawList := data.Get(attr.Access).([]interface{})
-	if len(rawList) == 0 || rawList[0] == nil {
-		return nil, nil
+func convertResource(ctx context.Context, data resourceModel) (*model.Resource, diag.Diagnostics) {
+	protocols, diags := convertProtocols(ctx, data.Protocols)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	groups, serviceAccounts, accessDiags := convertAccess(ctx, data.Access)
+	diags.Append(accessDiags...)
+
+	res := &model.Resource{
+		Name:            data.Name.ValueString(),
+		RemoteNetworkID: data.RemoteNetworkID.ValueString(),
+		Address:         data.Address.ValueString(),
+		Protocols:       protocols,
+		Groups:          groups,
+		ServiceAccounts: serviceAccounts,
+		IsAuthoritative: data.IsAuthoritative.IsNull() || data.IsAuthoritative.IsUnknown() || data.IsAuthoritative.ValueBool(),
+		IsActive:        data.IsActive.IsNull() || data.IsActive.IsUnknown() || data.IsActive.ValueBool(),
+		Alias:           data.Alias.ValueStringPointer(),
 	}
 
-	rawMap := rawList[0].(map[string]interface{})
+	if !data.IsVisible.IsUnknown() {
+		res.IsVisible = data.IsVisible.ValueBoolPointer()
+	}
+
+	if !data.IsBrowserShortcutEnabled.IsUnknown() {
+		res.IsBrowserShortcutEnabled = data.IsBrowserShortcutEnabled.ValueBoolPointer()
+	}
 
-	return convertIDs(rawMap[attr.GroupIDs]), convertIDs(rawMap[attr.ServiceAccountIDs])
+	return res, diags
 }
 
-func convertAuthoritativeFlag(data *schema.ResourceData) bool {
-	flag, hasFlag := data.GetOkExists(attr.IsAuthoritative) //nolint:staticcheck
+func convertAccess(ctx context.Context, access []accessModel) ([]string, []string, diag.Diagnostics) {
+	if len(access) == 0 {
+		return nil, nil, nil
+	}
+
+	var (
+		groups, serviceAccounts []string
+		diags                   diag.Diagnostics
+	)
 
-	if hasFlag {
-		return flag.(bool)
+	if !access[0].GroupIDs.IsUnknown() {
+		diags.Append(access[0].GroupIDs.ElementsAs(ctx, &groups, false)...)
 	}
 
-	// default value
-	return true
+	if !access[0].ServiceAccountIDs.IsUnknown() {
+		diags.Append(access[0].ServiceAccountIDs.ElementsAs(ctx, &serviceAccounts, false)...)
+	}
+
+	return groups, serviceAccounts, diags
 }
 
-func convertProtocols(data *schema.ResourceData) (*model.Protocols, error) {
-	rawList := data.Get(attr.Protocols).([]interface{})
-	if len(rawList) == 0 {
+func convertProtocols(_ context.Context, protocols []protocolsModel) (*model.Protocols, diag.Diagnostics) {
+	if len(protocols) == 0 {
 		return model.DefaultProtocols(), nil
 	}
 
-	rawMap := rawList[0].(map[string]interface{})
+	var diags diag.Diagnostics
 
-	udp, err := convertProtocol(rawMap[attr.UDP].([]interface{}))
+	udp, err := convertProtocol(protocols[0].UDP)
 	if err != nil {
-		return nil, err
+		diags.AddAttributeError(path.Root(attr.Protocols).AtListIndex(0).AtName(attr.UDP), "Invalid protocol", err.Error())
 	}
 
-	tcp, err := convertProtocol(rawMap[attr.TCP].([]interface{}))
+	tcp, err := convertProtocol(protocols[0].TCP)
 	if err != nil {
-		return nil, err
+		diags.AddAttributeError(path.Root(attr.Protocols).AtListIndex(0).AtName(attr.TCP), "Invalid protocol", err.Error())
+	}
+
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	return &model.Protocols{
 		UDP:       udp,
 		TCP:       tcp,
-		AllowIcmp: rawMap[attr.AllowIcmp].(bool),
+		AllowIcmp: protocols[0].AllowIcmp.IsNull() || protocols[0].AllowIcmp.ValueBool(),
 	}, nil
 }
 
-func convertProtocol(rawList []interface{}) (*model.Protocol, error) {
-	if len(rawList) == 0 {
+func convertProtocol(protocols []protocolModel) (*model.Protocol, error) {
+	if len(protocols) == 0 {
 		return nil, nil //nolint:nilnil
 	}
 
-	rawMap := rawList[0].(map[string]interface{})
-	policy := rawMap[attr.Policy].(string)
-
-	ports, err := convertPorts(rawMap[attr.Ports].([]interface{}))
+	ports, err := convertPorts(listStrings(protocols[0].Ports))
 	if err != nil {
 		return nil, err
 	}
 
-	return model.NewProtocol(policy, ports), nil
+	return model.NewProtocol(protocols[0].Policy.ValueString(), model.MergePortRanges(ports)), nil
 }
 
-func convertPorts(rawList []interface{}) ([]*model.PortRange, error) {
+func convertPorts(rawList []string) ([]*model.PortRange, error) {
 	var ports = make([]*model.PortRange, 0, len(rawList))
 
 	for _, port := range rawList {
-		var str string
-		if port != nil {
-			str = port.(string)
-		}
-
-		portRange, err := model.NewPortRange(str)
+		portRange, err := model.NewPortRange(port)
 		if err != nil {
 			return nil, err //nolint:wrapcheck
 		}
@@ -615,10 +1006,14 @@ func convertPorts(rawList []interface{}) ([]*model.PortRange, error) {
 	return ports, nil
 }
 
-func convertUsers(data *schema.ResourceData) []string {
-	if ids, ok := data.GetOk(attr.UserIDs); ok {
-		return convertIDs(ids)
+func listStrings(list types.List) []string {
+	values := make([]string, 0, len(list.Elements()))
+
+	for _, elem := range list.Elements() {
+		if str, ok := elem.(types.String); ok {
+			values = append(values, str.ValueString())
+		}
 	}
 
-	return nil
+	return values
 }
@@ -1,21 +1,325 @@
 package resource
 
 import (
+	"context"
 	"testing"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-func TestResourceResourceReadDiagnosticsError(t *testing.T) {
-	t.Run("Test Soc2bd Resource : Resource Read Diagnostics Error", func(t *testing.T) {
-		res := &model.Resource{
-			Groups:    []string{},
-			Protocols: &model.Protocols{},
+func stringList(values ...string) types.List {
+	return types.ListValueMust(types.StringType, stringValues(values))
+}
+
+func stringSet(values ...string) types.Set {
+	return types.SetValueMust(types.StringType, stringValues(values))
+}
+
+func TestResourceState(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Resource State Without Protocols", func(t *testing.T) {
+		state, diags := resourceState(context.Background(), resourceModel{}, &model.Resource{
+			ID:        "id",
+			Name:      "name",
+			Protocols: model.DefaultProtocols(),
+			Groups:    []string{"group-1"},
+			IsVisible: boolPtr(true),
+		})
+		require.False(t, diags.HasError())
+
+		assert.Equal(t, "id", state.ID.ValueString())
+		assert.True(t, state.IsAuthoritative.ValueBool())
+		assert.True(t, state.IsVisible.ValueBool())
+		assert.True(t, state.IsBrowserShortcutEnabled.IsNull())
+		assert.Nil(t, state.Protocols)
+		require.Len(t, state.Access, 1)
+		assert.Equal(t, stringSet("group-1"), state.Access[0].GroupIDs)
+		assert.True(t, state.Access[0].ServiceAccountIDs.IsNull())
+		assert.False(t, state.IsActive.ValueBool())
+		assert.False(t, state.DeactivateOnDestroy.ValueBool())
+	})
+
+	t.Run("Test Soc2bd Resource : Resource State Keeps Deactivate On Destroy", func(t *testing.T) {
+		state, diags := resourceState(context.Background(), resourceModel{DeactivateOnDestroy: types.BoolValue(true)}, &model.Resource{
+			IsActive: true,
+		})
+		require.False(t, diags.HasError())
+
+		assert.True(t, state.IsActive.ValueBool())
+		assert.True(t, state.DeactivateOnDestroy.ValueBool())
+	})
+
+	t.Run("Test Soc2bd Resource : Resource State Keeps Configured Protocols", func(t *testing.T) {
+		prior := resourceModel{
+			Protocols: []protocolsModel{{
+				AllowIcmp: types.BoolValue(true),
+				TCP:       []protocolModel{{Policy: types.StringValue(model.PolicyRestricted), Ports: stringList("80", "81")}},
+				UDP:       []protocolModel{{Policy: types.StringValue(model.PolicyDenyAll), Ports: types.ListNull(types.StringType)}},
+			}},
+		}
+
+		state, diags := resourceState(context.Background(), prior, &model.Resource{
+			Protocols: &model.Protocols{
+				AllowIcmp: true,
+				TCP:       &model.Protocol{Policy: model.PolicyRestricted, Ports: []*model.PortRange{{Start: 80, End: 81}}},
+				UDP:       &model.Protocol{Policy: model.PolicyRestricted},
+			},
+		})
+		require.False(t, diags.HasError())
+
+		assert.Equal(t, prior.Protocols, state.Protocols)
+	})
+
+	t.Run("Test Soc2bd Resource : Resource State Protocols Drift", func(t *testing.T) {
+		state, diags := resourceState(context.Background(), resourceModel{}, &model.Resource{
+			Protocols: &model.Protocols{
+				AllowIcmp: false,
+				TCP:       &model.Protocol{Policy: model.PolicyRestricted, Ports: []*model.PortRange{{Start: 80, End: 81}}},
+				UDP:       model.DefaultProtocol(),
+			},
+		})
+		require.False(t, diags.HasError())
+
+		require.Len(t, state.Protocols, 1)
+		assert.False(t, state.Protocols[0].AllowIcmp.ValueBool())
+		assert.Equal(t, []protocolModel{{Policy: types.StringValue(model.PolicyRestricted), Ports: stringList("80-81")}}, state.Protocols[0].TCP)
+		assert.Equal(t, []protocolModel{{Policy: types.StringValue(model.PolicyAllowAll), Ports: types.ListNull(types.StringType)}}, state.Protocols[0].UDP)
+	})
+
+	t.Run("Test Soc2bd Resource : Resource State Non Authoritative", func(t *testing.T) {
+		prior := resourceModel{
+			IsAuthoritative: types.BoolValue(false),
+			Access: []accessModel{{
+				GroupIDs:          stringSet("group-1"),
+				ServiceAccountIDs: types.SetValueMust(types.StringType, nil),
+			}},
 		}
-		d := &schema.ResourceData{}
-		diags := readDiagnostics(d, res)
-		assert.True(t, diags.HasError())
+
+		state, diags := resourceState(context.Background(), prior, &model.Resource{
+			Groups:          []string{"group-1", "group-2"},
+			ServiceAccounts: []string{"account-1"},
+		})
+		require.False(t, diags.HasError())
+
+		assert.False(t, state.IsAuthoritative.ValueBool())
+		assert.Equal(t, prior.Access, state.Access)
 	})
 }
+
+func TestPortsPlanModifier(t *testing.T) {
+	cases := []struct {
+		name     string
+		config   types.List
+		state    types.List
+		expected types.List
+	}{
+		{
+			name:     "same ports",
+			config:   stringList("80", "81"),
+			state:    stringList("80-81"),
+			expected: stringList("80-81"),
+		},
+		{
+			name:     "changed ports",
+			config:   stringList("80"),
+			state:    stringList("80-81"),
+			expected: stringList("80"),
+		},
+		{
+			name:     "empty state",
+			config:   types.ListNull(types.StringType),
+			state:    stringList(),
+			expected: stringList(),
+		},
+		{
+			name:     "create",
+			config:   types.ListNull(types.StringType),
+			state:    types.ListNull(types.StringType),
+			expected: types.ListNull(types.StringType),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run("Test Soc2bd Resource : Ports Plan Modifier "+c.name, func(t *testing.T) {
+			resp := &planmodifier.ListResponse{PlanValue: types.ListUnknown(types.StringType)}
+
+			portsPlanModifier{}.PlanModifyList(context.Background(), planmodifier.ListRequest{ConfigValue: c.config, StateValue: c.state}, resp)
+
+			assert.Equal(t, c.expected, resp.PlanValue)
+		})
+	}
+}
+
+func TestEmptySetPlanModifier(t *testing.T) {
+	cases := []struct {
+		name     string
+		config   types.Set
+		state    types.Set
+		expected types.Set
+	}{
+		{
+			name:     "empty state",
+			config:   types.SetNull(types.StringType),
+			state:    stringSet(),
+			expected: stringSet(),
+		},
+		{
+			name:     "removed ids",
+			config:   types.SetNull(types.StringType),
+			state:    stringSet("group-1"),
+			expected: types.SetNull(types.StringType),
+		},
+		{
+			name:     "configured ids",
+			config:   stringSet("group-1"),
+			state:    types.SetNull(types.StringType),
+			expected: stringSet("group-1"),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run("Test Soc2bd Resource : Empty Set Plan Modifier "+c.name, func(t *testing.T) {
+			resp := &planmodifier.SetResponse{PlanValue: types.SetUnknown(types.StringType)}
+
+			emptySetPlanModifier{}.PlanModifySet(context.Background(), planmodifier.SetRequest{ConfigValue: c.config, StateValue: c.state}, resp)
+
+			assert.Equal(t, c.expected, resp.PlanValue)
+		})
+	}
+}
+
+func TestAddressTypeState(t *testing.T) {
+	assert.Equal(t, types.StringValue(model.AddressTypeCIDR), addressTypeState("10.0.0.0/24"))
+	assert.Equal(t, types.StringValue(model.AddressTypeWildcard), addressTypeState("*.corp.com"))
+	assert.True(t, addressTypeState("10.0.0.1/24").IsNull())
+}
+
+func TestAddressValidator(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    types.String
+		expected bool
+	}{
+		{name: "ip", value: types.StringValue("10.0.0.1")},
+		{name: "fqdn", value: types.StringValue("db.corp.com")},
+		{name: "unknown", value: types.StringUnknown()},
+		{name: "host bits", value: types.StringValue("10.0.0.1/24"), expected: true},
+		{name: "empty label", value: types.StringValue("*.corp..com"), expected: true},
+	}
+
+	for _, c := range cases {
+		t.Run("Test Soc2bd Resource : Address Validator "+c.name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+
+			addressValidator{}.ValidateString(context.Background(), validator.StringRequest{ConfigValue: c.value}, resp)
+
+			assert.Equal(t, c.expected, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestAliasValidator(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    types.String
+		expected bool
+	}{
+		{name: "valid", value: types.StringValue("db.internal")},
+		{name: "null", value: types.StringNull()},
+		{name: "empty", value: types.StringValue("")},
+		{name: "wildcard", value: types.StringValue("*.internal"), expected: true},
+		{name: "leading hyphen", value: types.StringValue("-db.internal"), expected: true},
+	}
+
+	for _, c := range cases {
+		t.Run("Test Soc2bd Resource : Alias Validator "+c.name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+
+			aliasValidator{}.ValidateString(context.Background(), validator.StringRequest{ConfigValue: c.value}, resp)
+
+			assert.Equal(t, c.expected, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestMergedPortsValidator(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    types.List
+		expected bool
+	}{
+		{name: "distinct ports", value: stringList("80", "443")},
+		{name: "invalid ports", value: stringList("80", "foo")},
+		{name: "unknown", value: types.ListUnknown(types.StringType)},
+		{name: "overlapping ports", value: stringList("80", "80-90", "91"), expected: true},
+		{name: "duplicate ports", value: stringList("443", "443"), expected: true},
+	}
+
+	for _, c := range cases {
+		t.Run("Test Soc2bd Resource : Merged Ports Validator "+c.name, func(t *testing.T) {
+			resp := &validator.ListResponse{}
+
+			mergedPortsValidator{}.ValidateList(context.Background(), validator.ListRequest{ConfigValue: c.value}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, c.expected, resp.Diagnostics.WarningsCount() == 1)
+		})
+	}
+}
+
+func TestRestrictedPolicyValidator(t *testing.T) {
+	protocolSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			attr.Policy: schema.StringAttribute{Required: true},
+			attr.Ports:  schema.ListAttribute{ElementType: types.StringType, Optional: true},
+		},
+	}
+
+	newConfig := func(policy string, ports tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: protocolSchema,
+			Raw: tftypes.NewValue(protocolSchema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+				attr.Policy: tftypes.NewValue(tftypes.String, policy),
+				attr.Ports:  ports,
+			}),
+		}
+	}
+
+	portsType := tftypes.List{ElementType: tftypes.String}
+
+	cases := []struct {
+		name     string
+		policy   string
+		ports    tftypes.Value
+		expected bool
+	}{
+		{name: "restricted with ports", policy: model.PolicyRestricted, ports: tftypes.NewValue(portsType, []tftypes.Value{tftypes.NewValue(tftypes.String, "80")})},
+		{name: "deny all", policy: model.PolicyDenyAll, ports: tftypes.NewValue(portsType, nil)},
+		{name: "unknown ports", policy: model.PolicyRestricted, ports: tftypes.NewValue(portsType, tftypes.UnknownValue)},
+		{name: "restricted without ports", policy: model.PolicyRestricted, ports: tftypes.NewValue(portsType, nil), expected: true},
+		{name: "restricted with empty ports", policy: model.PolicyRestricted, ports: tftypes.NewValue(portsType, []tftypes.Value{}), expected: true},
+	}
+
+	for _, c := range cases {
+		t.Run("Test Soc2bd Resource : Restricted Policy Validator "+c.name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+
+			restrictedPolicyValidator{}.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root(attr.Policy),
+				ConfigValue: types.StringValue(c.policy),
+				Config:      newConfig(c.policy, c.ports),
+			}, resp)
+
+			assert.Equal(t, c.expected, resp.Diagnostics.HasError())
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const maxSessionLifetimeHours = 8760
+
+func SecurityPolicy() *schema.Resource {
+	devicePosture := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			attr.RequireScreenLock: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether devices must have a screen lock enabled",
+			},
+			attr.RequireDiskEncryption: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether devices must have their disk encrypted",
+			},
+			attr.RequireFirewall: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether devices must have a firewall enabled",
+			},
+			attr.AllowedOperatingSystems: {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(model.OperatingSystems, false)},
+				Optional:    true,
+				Description: fmt.Sprintf("The operating systems devices may run, all are allowed when empty. Can be any of: %s.", strings.Join(model.OperatingSystems, ", ")),
+			},
+		},
+	}
+
+	return withLogging(Soc2bdSecurityPolicy, &schema.Resource{
+		Description:   "Security Policies determine user and device authentication requirements for the Groups they are assigned to. For more information, see Soc2bd's [documentation](https://docs.soc2bd.com/docs/security-policies).",
+		CreateContext: securityPolicyCreate,
+		ReadContext:   securityPolicyRead,
+		UpdateContext: securityPolicyUpdate,
+		DeleteContext: securityPolicyDelete,
+
+		Schema: map[string]*schema.Schema{
+			attr.Name: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the Security Policy",
+			},
+			// optional
+			attr.RequireMFA: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether users must authenticate with multi-factor authentication (MFA). Default is `false`.",
+			},
+			attr.MFAMethods: {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(model.MFAMethods, false)},
+				Optional:    true,
+				Description: fmt.Sprintf("The MFA methods users may authenticate with, all are allowed when empty. Can be any of: %s.", strings.Join(model.MFAMethods, ", ")),
+			},
+			attr.SessionLifetimeHours: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, maxSessionLifetimeHours),
+				Description:  fmt.Sprintf("How many hours a session lasts before users have to authenticate again, up to %d. Default is `0`, sessions don't expire.", maxSessionLifetimeHours),
+			},
+			attr.DevicePosture: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        devicePosture,
+				Description: "The checks devices must pass to connect. By default or when this argument is not defined, there are no device requirements.",
+			},
+			attr.GroupIDs: {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Description: "List of Group IDs the Security Policy is assigned to. Groups assigned to the Security Policy outside of this resource are ignored, " +
+					"don't set `security_policy_id` on the same `soc2bd_group`.",
+			},
+			attr.DeletionProtection: deletionProtectionSchema("Security Policy"),
+			// computed
+			attr.ID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Autogenerated ID of the Security Policy, encoded in base64",
+			},
+		},
+		Importer: importer(resolveSecurityPolicyImport, []string{importKeyName}),
+	})
+}
+
+func securityPolicyCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*provider.Meta).Client
+
+	policy, err := c.CreateSecurityPolicy(ctx, convertSecurityPolicy(resourceData))
+	if err != nil {
+		return ErrDiagnostics(err)
+	}
+
+	tflog.SubsystemInfo(ctx, Soc2bdSecurityPolicy, "Created security policy", map[string]interface{}{client.LogFieldEntityID: policy.ID, attr.Name: policy.Name})
+
+	resourceData.SetId(policy.ID)
+
+	return securityPolicyRead(ctx, resourceData, meta)
+}
+
+func securityPolicyUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*provider.Meta).Client
+	policy := convertSecurityPolicy(resourceData)
+
+	old, _ := resourceData.GetChange(attr.GroupIDs)
+	if err := c.DeleteSecurityPolicyGroups(ctx, policy.ID, setDifference(convertIDs(old), policy.Groups)); err != nil {
+		return ErrDiagnostics(err)
+	}
+
+	if _, err := c.UpdateSecurityPolicy(ctx, policy); err != nil {
+		return ErrDiagnostics(err)
+	}
+
+	tflog.SubsystemInfo(ctx, Soc2bdSecurityPolicy, "Updated security policy")
+
+	return securityPolicyRead(ctx, resourceData, meta)
+}
+
+func securityPolicyDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*provider.Meta).Client
+
+	if diags := checkDeletionProtection(meta, Soc2bdSecurityPolicy, resourceData); diags.HasError() {
+		return diags
+	}
+
+	if err := c.DeleteSecurityPolicy(ctx, resourceData.Id()); err != nil {
+		return ErrDiagnostics(err)
+	}
+
+	tflog.SubsystemInfo(ctx, Soc2bdSecurityPolicy, "Deleted security policy")
+
+	return nil
+}
+
+func securityPolicyRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*provider.Meta).Client
+
+	policy, err := c.ReadSecurityPolicyRules(ctx, resourceData.Id())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// clear state
+			resourceData.SetId("")
+
+			return nil
+		}
+
+		return ErrDiagnostics(err)
+	}
+
+	return resourceSecurityPolicyReadHelper(resourceData, policy)
+}
+
+func resourceSecurityPolicyReadHelper(resourceData *schema.ResourceData, policy *model.SecurityPolicy) diag.Diagnostics {
+	resourceData.SetId(policy.ID)
+
+	if err := resourceData.Set(attr.Name, policy.Name); err != nil {
+		return ErrAttributeSet(err, attr.Name)
+	}
+
+	if err := resourceData.Set(attr.RequireMFA, policy.RequireMFA); err != nil {
+		return ErrAttributeSet(err, attr.RequireMFA)
+	}
+
+	if err := resourceData.Set(attr.MFAMethods, policy.MFAMethods); err != nil {
+		return ErrAttributeSet(err, attr.MFAMethods)
+	}
+
+	if err := resourceData.Set(attr.SessionLifetimeHours, policy.SessionLifetimeHours); err != nil {
+		return ErrAttributeSet(err, attr.SessionLifetimeHours)
+	}
+
+	// a configured block with the default values is kept, otherwise no requirements are shown as no block
+	_, hasDevicePosture := resourceData.GetOk(attr.DevicePosture)
+	if err := resourceData.Set(attr.DevicePosture, convertDevicePostureToTerraform(policy.DevicePosture, hasDevicePosture)); err != nil {
+		return ErrAttributeSet(err, attr.DevicePosture)
+	}
+
+	if ids, exists := resourceData.GetOk(attr.GroupIDs); exists {
+		if err := resourceData.Set(attr.GroupIDs, setIntersection(convertIDs(ids), policy.Groups)); err != nil {
+			return ErrAttributeSet(err, attr.GroupIDs)
+		}
+	}
+
+	return nil
+}
+
+func convertSecurityPolicy(data *schema.ResourceData) *model.SecurityPolicy {
+	policy := &model.SecurityPolicy{
+		ID:                   data.Id(),
+		Name:                 data.Get(attr.Name).(string),
+		RequireMFA:           data.Get(attr.RequireMFA).(bool),
+		MFAMethods:           convertIDs(data.Get(attr.MFAMethods)),
+		SessionLifetimeHours: data.Get(attr.SessionLifetimeHours).(int),
+		DevicePosture:        &model.DevicePosture{},
+	}
+
+	if ids, ok := data.GetOk(attr.GroupIDs); ok {
+		policy.Groups = convertIDs(ids)
+	}
+
+	if blocks := data.Get(attr.DevicePosture).([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+		posture := blocks[0].(map[string]interface{})
+		policy.DevicePosture = &model.DevicePosture{
+			RequireScreenLock:       posture[attr.RequireScreenLock].(bool),
+			RequireDiskEncryption:   posture[attr.RequireDiskEncryption].(bool),
+			RequireFirewall:         posture[attr.RequireFirewall].(bool),
+			AllowedOperatingSystems: convertIDs(posture[attr.AllowedOperatingSystems]),
+		}
+	}
+
+	return policy
+}
+
+func convertDevicePostureToTerraform(posture *model.DevicePosture, keepEmpty bool) []interface{} {
+	if posture.IsEmpty() {
+		if !keepEmpty {
+			return nil
+		}
+
+		posture = &model.DevicePosture{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			attr.RequireScreenLock:       posture.RequireScreenLock,
+			attr.RequireDiskEncryption:   posture.RequireDiskEncryption,
+			attr.RequireFirewall:         posture.RequireFirewall,
+			attr.AllowedOperatingSystems: posture.AllowedOperatingSystems,
+		},
+	}
+}
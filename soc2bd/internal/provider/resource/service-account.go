@@ -2,18 +2,18 @@ package resource
 
 import (
 	"context"
-	"errors"
-	"log"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ServiceAccount() *schema.Resource {
-	return &schema.Resource{
+	return withLogging(Soc2bdServiceAccount, &schema.Resource{
 		Description:   "Service Accounts offer a way to provide programmatic, centrally-controlled, and consistent access controls.",
 		CreateContext: serviceAccountCreate,
 		ReadContext:   serviceAccountRead,
@@ -26,6 +26,7 @@ func ServiceAccount() *schema.Resource {
 				Required:    true,
 				Description: "The name of the Service Account in Soc2bd",
 			},
+			attr.DeletionProtection: deletionProtectionSchema("Service Account"),
 			// computed
 			attr.ID: {
 				Type:        schema.TypeString,
@@ -33,27 +34,25 @@ func ServiceAccount() *schema.Resource {
 				Description: "Autogenerated ID of the Service Account",
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-	}
+		Importer: importer(resolveServiceAccountImport, []string{importKeyName}),
+	})
 }
 
 func serviceAccountCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 
 	serviceAccount, err := c.CreateServiceAccount(ctx, resourceData.Get(attr.Name).(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Service account %s created with id %v", serviceAccount.Name, serviceAccount.ID)
+	tflog.SubsystemInfo(ctx, Soc2bdServiceAccount, "Created service account", map[string]interface{}{client.LogFieldEntityID: serviceAccount.ID, attr.Name: serviceAccount.Name})
 
 	return serviceAccountReadHelper(resourceData, serviceAccount, nil)
 }
 
 func serviceAccountUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 
 	group, err := c.UpdateServiceAccount(ctx,
 		&model.ServiceAccount{
@@ -62,29 +61,33 @@ func serviceAccountUpdate(ctx context.Context, resourceData *schema.ResourceData
 		},
 	)
 	if err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Updated service account id %v", group.ID)
+	tflog.SubsystemInfo(ctx, Soc2bdServiceAccount, "Updated service account")
 
 	return serviceAccountReadHelper(resourceData, group, err)
 }
 
 func serviceAccountDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
+
+	if diags := checkDeletionProtection(meta, Soc2bdServiceAccount, resourceData); diags.HasError() {
+		return diags
+	}
 
 	err := c.DeleteServiceAccount(ctx, resourceData.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Deleted service account id %s", resourceData.Id())
+	tflog.SubsystemInfo(ctx, Soc2bdServiceAccount, "Deleted service account")
 
 	return nil
 }
 
 func serviceAccountRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 	serviceAccount, err := c.ReadShallowServiceAccount(ctx, resourceData.Id())
 
 	return serviceAccountReadHelper(resourceData, serviceAccount, err)
@@ -92,14 +95,14 @@ func serviceAccountRead(ctx context.Context, resourceData *schema.ResourceData,
 
 func serviceAccountReadHelper(resourceData *schema.ResourceData, serviceAccount *model.ServiceAccount, err error) diag.Diagnostics {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if client.IsNotFound(err) {
 			// clear state
 			resourceData.SetId("")
 
 			return nil
 		}
 
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
 	if err := resourceData.Set(attr.Name, serviceAccount.Name); err != nil {
@@ -2,23 +2,28 @@ package resource
 
 import (
 	"context"
-	"errors"
-	"log"
+	"fmt"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const maxServiceKeyExpirationDays = 365
+
 func ServiceKey() *schema.Resource {
-	return &schema.Resource{
+	return withLogging(Soc2bdServiceAccountKey, &schema.Resource{
 		Description:   "A Service Key authorizes access to all Resources assigned to a Service Account.",
 		CreateContext: serviceKeyCreate,
 		ReadContext:   serviceKeyRead,
 		DeleteContext: serviceKeyDelete,
 		UpdateContext: serviceKeyUpdate,
+		CustomizeDiff: serviceKeyCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			attr.ServiceAccountID: {
@@ -33,6 +38,16 @@ func ServiceKey() *schema.Resource {
 				Computed:    true,
 				Description: "The name of the Service Key",
 			},
+			attr.ExpirationTime: {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IntBetween(0, maxServiceKeyExpirationDays),
+				DiffSuppressFunc: suppressLegacyExpirationTimeDiff,
+				Description:      fmt.Sprintf("Specifies how many days until the Service Key expires, between 0 and %d. 0 (the default) means the key never expires. An expired key is replaced on the next apply.", maxServiceKeyExpirationDays),
+			},
+			attr.DeletionProtection: deletionProtectionSchema("Service Account Key"),
 			// computed
 			attr.ID: {
 				Type:        schema.TypeString,
@@ -45,35 +60,54 @@ func ServiceKey() *schema.Resource {
 				Sensitive:   true,
 				Description: "Autogenerated Service Key token. Used to configure a Soc2bd Client running in headless mode.",
 			},
+			attr.ExpiresAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the Service Key expires at, in RFC3339 format. Empty when the key never expires.",
+			},
+			attr.Status: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("The status of the Service Key: %s, %s or %s. A key that is not active is replaced on the next apply.", model.StatusActive, model.StatusRevoked, model.StatusExpired),
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-	}
+	})
 }
 
 func serviceKeyCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
+
+	expirationTime := resourceData.Get(attr.ExpirationTime).(int)
 
-	serviceKey, err := client.CreateServiceKey(ctx, &model.ServiceKey{
-		Service: resourceData.Get(attr.ServiceAccountID).(string),
-		Name:    resourceData.Get(attr.Name).(string),
+	serviceKey, err := c.CreateServiceKey(ctx, &model.ServiceKey{
+		Service:        resourceData.Get(attr.ServiceAccountID).(string),
+		Name:           resourceData.Get(attr.Name).(string),
+		ExpirationTime: expirationTime,
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Service key %s created with id %v", serviceKey.Name, serviceKey.ID)
+	// the API only returns the expiry date, so the days requested are kept as configured
+	if err := resourceData.Set(attr.ExpirationTime, expirationTime); err != nil {
+		return ErrAttributeSet(err, attr.ExpirationTime)
+	}
+
+	ctx = client.MaskLogValues(ctx, Soc2bdServiceAccountKey, serviceKey.Token)
+	tflog.SubsystemInfo(ctx, Soc2bdServiceAccountKey, "Created service key", map[string]interface{}{client.LogFieldEntityID: serviceKey.ID, attr.Name: serviceKey.Name})
 
 	if err := resourceData.Set(attr.Token, serviceKey.Token); err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
-	return serviceKeyReadHelper(ctx, resourceData, serviceKey, nil, meta)
+	return serviceKeyReadHelper(resourceData, serviceKey, nil)
 }
 
 func serviceKeyUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*client.Client)
+	client := meta.(*provider.Meta).Client
 
 	serviceKey, err := client.UpdateServiceKey(ctx,
 		&model.ServiceKey{
@@ -82,60 +116,60 @@ func serviceKeyUpdate(ctx context.Context, resourceData *schema.ResourceData, me
 		},
 	)
 	if err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Updated service key id %v", serviceKey.ID)
+	tflog.SubsystemInfo(ctx, Soc2bdServiceAccountKey, "Updated service key")
 
-	return serviceKeyReadHelper(ctx, resourceData, serviceKey, err, meta)
+	return serviceKeyReadHelper(resourceData, serviceKey, err)
 }
 
 func serviceKeyDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*client.Client)
+	client := meta.(*provider.Meta).Client
+
+	if diags := checkDeletionProtection(meta, Soc2bdServiceAccountKey, resourceData); diags.HasError() {
+		return diags
+	}
 
 	serviceKey, err := client.ReadServiceKey(ctx, resourceData.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
 	if serviceKey.IsActive() {
 		err := client.RevokeServiceKey(ctx, resourceData.Id())
 		if err != nil {
-			return diag.FromErr(err)
+			return ErrDiagnostics(err)
 		}
 	}
 
 	err = client.DeleteServiceKey(ctx, resourceData.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Deleted service key id %s", resourceData.Id())
+	tflog.SubsystemInfo(ctx, Soc2bdServiceAccountKey, "Deleted service key")
 
 	return nil
 }
 
 func serviceKeyRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*client.Client)
+	client := meta.(*provider.Meta).Client
 	serviceKey, err := client.ReadServiceKey(ctx, resourceData.Id())
 
-	return serviceKeyReadHelper(ctx, resourceData, serviceKey, err, meta)
+	return serviceKeyReadHelper(resourceData, serviceKey, err)
 }
 
-func serviceKeyReadHelper(ctx context.Context, resourceData *schema.ResourceData, serviceKey *model.ServiceKey, err error, meta interface{}) diag.Diagnostics {
+func serviceKeyReadHelper(resourceData *schema.ResourceData, serviceKey *model.ServiceKey, err error) diag.Diagnostics {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if client.IsNotFound(err) {
 			// clear state
 			resourceData.SetId("")
 
 			return nil
 		}
 
-		return diag.FromErr(err)
-	}
-
-	if !serviceKey.IsActive() {
-		return reCreateServiceKey(ctx, resourceData, meta)
+		return ErrDiagnostics(err)
 	}
 
 	if err := resourceData.Set(attr.Name, serviceKey.Name); err != nil {
@@ -146,18 +180,41 @@ func serviceKeyReadHelper(ctx context.Context, resourceData *schema.ResourceData
 		return ErrAttributeSet(err, attr.ServiceAccountID)
 	}
 
+	if err := resourceData.Set(attr.ExpiresAt, serviceKey.ExpiresAt); err != nil {
+		return ErrAttributeSet(err, attr.ExpiresAt)
+	}
+
+	if err := resourceData.Set(attr.Status, serviceKey.GetStatus()); err != nil {
+		return ErrAttributeSet(err, attr.Status)
+	}
+
 	resourceData.SetId(serviceKey.ID)
 
 	return nil
 }
 
-func reCreateServiceKey(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*client.Client)
+// suppressLegacyExpirationTimeDiff keeps the keys created before the expiration time was added, or imported,
+// as they have no expiration time in state and replacing them would issue new tokens.
+func suppressLegacyExpirationTimeDiff(_, oldValue, newValue string, resourceData *schema.ResourceData) bool {
+	return resourceData.Id() != "" && oldValue == "" && (newValue == "" || newValue == "0")
+}
 
-	err := client.DeleteServiceKey(ctx, resourceData.Id())
-	if err != nil {
-		return diag.FromErr(err)
+// serviceKeyCustomizeDiff replaces revoked and expired keys, as those can't be reactivated.
+func serviceKeyCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	status := diff.Get(attr.Status).(string)
+	if status == "" || status == model.StatusActive {
+		return nil
+	}
+
+	tflog.SubsystemInfo(ctx, Soc2bdServiceAccountKey, "Service key will be replaced", map[string]interface{}{attr.Status: status})
+
+	if err := diff.SetNewComputed(attr.Status); err != nil {
+		return err //nolint:wrapcheck
 	}
 
-	return serviceKeyCreate(ctx, resourceData, meta)
+	return diff.ForceNew(attr.Status) //nolint:wrapcheck
 }
@@ -0,0 +1,106 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceKeyDiffReplacesInactiveKey(t *testing.T) {
+	cases := []struct {
+		status      string
+		requiresNew bool
+	}{
+		{status: model.StatusActive, requiresNew: false},
+		{status: model.StatusRevoked, requiresNew: true},
+		{status: model.StatusExpired, requiresNew: true},
+	}
+
+	for _, c := range cases {
+		t.Run("Test Soc2bd Resource : Service Key Diff "+c.status, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "key-id",
+				Attributes: map[string]string{
+					attr.ID:               "key-id",
+					attr.ServiceAccountID: "account-id",
+					attr.Name:             "key",
+					attr.ExpirationTime:   "0",
+					attr.Status:           c.status,
+				},
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				attr.ServiceAccountID: "account-id",
+				attr.Name:             "key",
+			})
+
+			diff, err := ServiceKey().SimpleDiff(context.Background(), state, config, nil)
+			require.NoError(t, err)
+
+			if !c.requiresNew {
+				assert.True(t, diff == nil || diff.Empty())
+
+				return
+			}
+
+			require.NotNil(t, diff)
+			assert.True(t, diff.RequiresNew())
+		})
+	}
+}
+
+func TestServiceKeyDiffKeepsKeysWithoutExpirationTime(t *testing.T) {
+	cases := []struct {
+		state       map[string]string
+		config      map[string]interface{}
+		requiresNew bool
+	}{
+		// state written before the expiration time was added
+		{config: map[string]interface{}{}},
+		{config: map[string]interface{}{attr.ExpirationTime: 0}},
+		{config: map[string]interface{}{attr.ExpirationTime: 90}, requiresNew: true},
+		{state: map[string]string{attr.ExpirationTime: "90"}, config: map[string]interface{}{}},
+		{state: map[string]string{attr.ExpirationTime: "90"}, config: map[string]interface{}{attr.ExpirationTime: 30}, requiresNew: true},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("Test Soc2bd Resource : Service Key Diff Expiration Time case_%d", n), func(t *testing.T) {
+			attributes := map[string]string{
+				attr.ID:               "key-id",
+				attr.ServiceAccountID: "account-id",
+				attr.Name:             "key",
+				attr.Token:            "token",
+				attr.Status:           model.StatusActive,
+			}
+			for key, value := range c.state {
+				attributes[key] = value
+			}
+
+			config := map[string]interface{}{
+				attr.ServiceAccountID: "account-id",
+				attr.Name:             "key",
+			}
+			for key, value := range c.config {
+				config[key] = value
+			}
+
+			diff, err := ServiceKey().SimpleDiff(context.Background(),
+				&terraform.InstanceState{ID: "key-id", Attributes: attributes}, terraform.NewResourceConfigRaw(config), nil)
+			require.NoError(t, err)
+
+			if !c.requiresNew {
+				assert.True(t, diff == nil || diff.Empty(), fmt.Sprintf("%v", diff))
+
+				return
+			}
+
+			require.NotNil(t, diff)
+			assert.True(t, diff.RequiresNew())
+		})
+	}
+}
@@ -2,14 +2,14 @@ package resource
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/utils"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,7 +18,7 @@ import (
 var ErrAllowedToChangeOnlyManualUsers = fmt.Errorf("only users of type %s may be modified", model.UserTypeManual)
 
 func User() *schema.Resource { //nolint:funlen
-	return &schema.Resource{
+	return withLogging(Soc2bdUser, &schema.Resource{
 		Description:   "Users provides different levels of write capabilities across the Soc2bd Admin Console. For more information, see Soc2bd's [documentation](https://www.soc2bd.com/docs/users).",
 		CreateContext: userCreate,
 		ReadContext:   userRead,
@@ -63,6 +63,7 @@ func User() *schema.Resource { //nolint:funlen
 				Description:  fmt.Sprintf("Determines the User's role. Either %s.", utils.DocList(model.UserRoles)),
 				ValidateFunc: validation.StringInSlice(model.UserRoles, false),
 			},
+			attr.DeletionProtection: deletionProtectionSchema("User"),
 			// computed
 			attr.Type: {
 				Type:        schema.TypeString,
@@ -75,62 +76,64 @@ func User() *schema.Resource { //nolint:funlen
 				Description: "Autogenerated ID of the User, encoded in base64.",
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-	}
+		Importer: importer(resolveUserImport, []string{importKeyEmail}),
+	})
 }
 
 func userCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 
-	user, err := client.CreateUser(ctx, convertUser(resourceData))
+	user, err := c.CreateUser(ctx, convertUser(resourceData))
 	if err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
-	log.Printf("[INFO] User %s created with id %v", user.Email, user.ID)
+	tflog.SubsystemInfo(ctx, Soc2bdUser, "Created user", map[string]interface{}{client.LogFieldEntityID: user.ID})
 
 	return resourceUserReadHelper(resourceData, user, nil)
 }
 
 func userUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*client.Client)
+	client := meta.(*provider.Meta).Client
 
 	err := isAllowedToChangeUser(resourceData)
 	if err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
 	user, err := client.UpdateUser(ctx, convertUserUpdate(resourceData))
 	if err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Updated user id %v", user.ID)
+	tflog.SubsystemInfo(ctx, Soc2bdUser, "Updated user")
 
 	return resourceUserReadHelper(resourceData, user, err)
 }
 
 func userDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*client.Client)
+	client := meta.(*provider.Meta).Client
+
+	if diags := checkDeletionProtection(meta, Soc2bdUser, resourceData); diags.HasError() {
+		return diags
+	}
 
 	err := isAllowedToChangeUser(resourceData)
 	if err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
 	if err := client.DeleteUser(ctx, resourceData.Id()); err != nil {
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
-	log.Printf("[INFO] Deleted user id %s", resourceData.Id())
+	tflog.SubsystemInfo(ctx, Soc2bdUser, "Deleted user")
 
 	return nil
 }
 
 func userRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	c := meta.(*client.Client)
+	c := meta.(*provider.Meta).Client
 
 	user, err := c.ReadUser(ctx, resourceData.Id())
 
@@ -139,14 +142,14 @@ func userRead(ctx context.Context, resourceData *schema.ResourceData, meta inter
 
 func resourceUserReadHelper(resourceData *schema.ResourceData, user *model.User, err error) diag.Diagnostics {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if client.IsNotFound(err) {
 			// clear state
 			resourceData.SetId("")
 
 			return nil
 		}
 
-		return diag.FromErr(err)
+		return ErrDiagnostics(err)
 	}
 
 	resourceData.SetId(user.ID)
@@ -0,0 +1,82 @@
+package datasource
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test/acctests"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatasourceSoc2bdAccessReport_basic(t *testing.T) {
+	t.Run("Test Soc2bd Datasource : Acc Access Report Basic", func(t *testing.T) {
+		email := test.RandomEmail()
+		name := test.RandomName()
+		theDatasource := "data.soc2bd_access_report.out_dar1"
+
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			Steps: []resource.TestStep{
+				{
+					Config: testDatasourceSoc2bdAccessReport(name, email),
+					Check: acctests.ComposeTestCheckFunc(
+						resource.TestMatchResourceAttr(theDatasource, attr.ContentHash, regexp.MustCompile(`^[0-9a-f]{64}$`)),
+						resource.TestCheckResourceAttrPair(theDatasource, attr.ID, theDatasource, attr.ContentHash),
+						resource.TestMatchResourceAttr(theDatasource, attr.CSV,
+							regexp.MustCompile(fmt.Sprintf(`USER,[^,]+,%s,[^,]+,%s,[^,]*,[^,]*,[^,]+,%s-web,10\.40\.0\.1,RESTRICTED:443,ALLOW_ALL,true`,
+								regexp.QuoteMeta(email), name, name))),
+						resource.TestMatchResourceAttr(theDatasource, attr.JSON, regexp.MustCompile(`"generated_at":"[^"]+","content_hash":"[0-9a-f]{64}"`)),
+						testCheckResourceAttrNotEqual(theDatasource, attr.RowCount, "0"),
+					),
+				},
+			},
+		})
+	})
+}
+
+func testDatasourceSoc2bdAccessReport(name, email string) string {
+	return fmt.Sprintf(`
+	resource "soc2bd_user" "u_dar1" {
+	  email = "%[2]s"
+	  send_invite = false
+	}
+
+	resource "soc2bd_group" "g_dar1" {
+	  name = "%[1]s"
+	  user_ids = [soc2bd_user.u_dar1.id]
+	}
+
+	resource "soc2bd_remote_network" "n_dar1" {
+	  name = "%[1]s"
+	}
+
+	resource "soc2bd_resource" "r_dar1" {
+	  name = "%[1]s-web"
+	  address = "10.40.0.1"
+	  remote_network_id = soc2bd_remote_network.n_dar1.id
+
+	  protocols {
+	    allow_icmp = true
+	    tcp {
+	      policy = "RESTRICTED"
+	      ports = ["443"]
+	    }
+	    udp {
+	      policy = "ALLOW_ALL"
+	    }
+	  }
+
+	  access {
+	    group_ids = [soc2bd_group.g_dar1.id]
+	  }
+	}
+
+	data "soc2bd_access_report" "out_dar1" {
+	  depends_on = [soc2bd_resource.r_dar1]
+	}
+	`, name, email)
+}
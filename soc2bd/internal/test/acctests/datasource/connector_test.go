@@ -8,6 +8,7 @@ import (
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test/acctests"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -18,15 +19,17 @@ func TestAccDatasourceSoc2bdConnector_basic(t *testing.T) {
 		connectorName := test.RandomConnectorName()
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdConnectorDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdConnectorDestroy,
 			Steps: []resource.TestStep{
 				{
 					Config: testDatasourceSoc2bdConnector(networkName, connectorName),
 					Check: acctests.ComposeTestCheckFunc(
 						resource.TestCheckOutput("my_connector", connectorName),
 						resource.TestCheckOutput("my_connector_notification_status", "true"),
+						// the connector isn't deployed, so it never sent a heartbeat
+						resource.TestCheckOutput("my_connector_state", model.ConnectorStateDeadNoHeartbeat),
 					),
 				},
 			},
@@ -55,6 +58,10 @@ func testDatasourceSoc2bdConnector(remoteNetworkName, connectorName string) stri
 	output "my_connector_notification_status" {
 	  value = data.soc2bd_connector.out_dc1.status_updates_enabled
 	}
+
+	output "my_connector_state" {
+	  value = data.soc2bd_connector.out_dc1.state
+	}
 	`, remoteNetworkName, connectorName)
 }
 
@@ -63,7 +70,7 @@ func TestAccDatasourceSoc2bdConnector_negative(t *testing.T) {
 		connectorID := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("Connector:%d", acctest.RandInt())))
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
 			PreCheck: func() {
 				acctests.PreCheck(t)
 			},
@@ -94,7 +101,7 @@ func TestAccDatasourceSoc2bdConnector_invalidID(t *testing.T) {
 		connectorID := acctest.RandString(10)
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
 			PreCheck: func() {
 				acctests.PreCheck(t)
 			},
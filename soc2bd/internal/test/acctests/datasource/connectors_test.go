@@ -22,9 +22,9 @@ func TestAccDatasourceSoc2bdConnectors_basic(t *testing.T) {
 		connectorName := test.RandomConnectorName()
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdConnectorDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdConnectorDestroy,
 			Steps: []resource.TestStep{
 				{
 					Config: testDatasourceSoc2bdConnectors(networkName1, connectorName, networkName2, connectorName, connectorName),
@@ -70,8 +70,8 @@ func TestAccDatasourceSoc2bdConnectors_emptyResult(t *testing.T) {
 		prefix := acctest.RandString(10)
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
 			Steps: []resource.TestStep{
 				{
 					Config: testSoc2bdConnectorsDoesNotExists(prefix),
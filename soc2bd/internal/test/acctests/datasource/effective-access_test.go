@@ -0,0 +1,112 @@
+package datasource
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test/acctests"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatasourceSoc2bdEffectiveAccess_basic(t *testing.T) {
+	t.Run("Test Soc2bd Datasource : Acc Effective Access Basic", func(t *testing.T) {
+		name := test.RandomName()
+		allowed := "data.soc2bd_effective_access.allowed_dea1"
+		denied := "data.soc2bd_effective_access.denied_dea1"
+
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			Steps: []resource.TestStep{
+				{
+					Config: testDatasourceSoc2bdEffectiveAccess(name, test.RandomEmail()),
+					Check: acctests.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(allowed, attr.Len(attr.Resources), "1"),
+						resource.TestCheckResourceAttrPair(allowed, attr.Path(attr.Resources, attr.ID), "soc2bd_resource.r_dea1", attr.ID),
+						resource.TestCheckTypeSetElemAttrPair(allowed, attr.GroupIDs+".*", "soc2bd_group.g_dea1", attr.ID),
+						resource.TestCheckTypeSetElemAttrPair(allowed, attr.UserIDs+".*", "soc2bd_user.u_dea1", attr.ID),
+						resource.TestCheckResourceAttr(denied, attr.Len(attr.Resources), "0"),
+						resource.TestCheckResourceAttr(denied, attr.Len(attr.UserIDs), "0"),
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccDatasourceSoc2bdEffectiveAccess_portWithICMP(t *testing.T) {
+	t.Run("Test Soc2bd Datasource : Acc Effective Access Port With ICMP", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					data "soc2bd_effective_access" "invalid" {
+					  address = "10.0.0.1"
+					  protocol = "ICMP"
+					  port = 80
+					}
+					`,
+					ExpectError: regexp.MustCompile("port can't be set when protocol is ICMP"),
+				},
+			},
+		})
+	})
+}
+
+func testDatasourceSoc2bdEffectiveAccess(name, email string) string {
+	return fmt.Sprintf(`
+	resource "soc2bd_user" "u_dea1" {
+	  email = "%[2]s"
+	  send_invite = false
+	}
+
+	resource "soc2bd_group" "g_dea1" {
+	  name = "%[1]s"
+	  user_ids = [soc2bd_user.u_dea1.id]
+	}
+
+	resource "soc2bd_remote_network" "n_dea1" {
+	  name = "%[1]s"
+	}
+
+	resource "soc2bd_resource" "r_dea1" {
+	  name = "%[1]s"
+	  address = "*.%[1]s.example.com"
+	  remote_network_id = soc2bd_remote_network.n_dea1.id
+
+	  protocols {
+	    allow_icmp = false
+	    tcp {
+	      policy = "RESTRICTED"
+	      ports = ["5432"]
+	    }
+	    udp {
+	      policy = "DENY_ALL"
+	    }
+	  }
+
+	  access {
+	    group_ids = [soc2bd_group.g_dea1.id]
+	  }
+	}
+
+	data "soc2bd_effective_access" "allowed_dea1" {
+	  address = "db.%[1]s.example.com"
+	  protocol = "TCP"
+	  port = 5432
+	  depends_on = [soc2bd_resource.r_dea1]
+	}
+
+	data "soc2bd_effective_access" "denied_dea1" {
+	  address = "db.%[1]s.example.com"
+	  protocol = "TCP"
+	  port = 22
+	  depends_on = [soc2bd_resource.r_dea1]
+	}
+	`, name, email)
+}
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test/acctests"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -24,9 +25,9 @@ func TestAccDatasourceSoc2bdGroup_basic(t *testing.T) {
 		testPolicy := securityPolicies[0]
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdGroupDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdGroupDestroy,
 			Steps: []resource.TestStep{
 				{
 					Config: testDatasourceSoc2bdGroup(groupName, testPolicy.ID),
@@ -71,12 +72,88 @@ func testDatasourceSoc2bdGroup(name, securityPolicyID string) string {
 	`, name, securityPolicyID)
 }
 
+func TestAccDatasourceSoc2bdGroup_usersAndResources(t *testing.T) {
+	t.Run("Test Soc2bd Datasource : Acc Group Users And Resources", func(t *testing.T) {
+		acctests.SetPageLimit(1)
+
+		groupName := test.RandomName()
+		theDatasource := "data.soc2bd_group.out_dg3"
+
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdGroupDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: testDatasourceSoc2bdGroupUsersAndResources(groupName, test.RandomEmail(), test.RandomEmail()),
+					Check: acctests.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(theDatasource, attr.Len(attr.UserIDs), "2"),
+						resource.TestCheckTypeSetElemAttrPair(theDatasource, attr.UserIDs+".*", "soc2bd_user.u1_dg3", attr.ID),
+						resource.TestCheckTypeSetElemAttrPair(theDatasource, attr.UserIDs+".*", "soc2bd_user.u2_dg3", attr.ID),
+						resource.TestCheckResourceAttr(theDatasource, attr.Len(attr.ResourceIDs), "2"),
+						resource.TestCheckTypeSetElemAttrPair(theDatasource, attr.ResourceIDs+".*", "soc2bd_resource.r1_dg3", attr.ID),
+						resource.TestCheckTypeSetElemAttrPair(theDatasource, attr.ResourceIDs+".*", "soc2bd_resource.r2_dg3", attr.ID),
+					),
+				},
+			},
+		})
+	})
+}
+
+func testDatasourceSoc2bdGroupUsersAndResources(name, email1, email2 string) string {
+	return fmt.Sprintf(`
+	resource "soc2bd_user" "u1_dg3" {
+	  email = "%[2]s"
+	  send_invite = false
+	}
+
+	resource "soc2bd_user" "u2_dg3" {
+	  email = "%[3]s"
+	  send_invite = false
+	}
+
+	resource "soc2bd_group" "g_dg3" {
+	  name = "%[1]s"
+	  user_ids = [soc2bd_user.u1_dg3.id, soc2bd_user.u2_dg3.id]
+	}
+
+	resource "soc2bd_remote_network" "n_dg3" {
+	  name = "%[1]s"
+	}
+
+	resource "soc2bd_resource" "r1_dg3" {
+	  name = "%[1]s-1"
+	  address = "10.30.0.1"
+	  remote_network_id = soc2bd_remote_network.n_dg3.id
+
+	  access {
+	    group_ids = [soc2bd_group.g_dg3.id]
+	  }
+	}
+
+	resource "soc2bd_resource" "r2_dg3" {
+	  name = "%[1]s-2"
+	  address = "10.30.0.2"
+	  remote_network_id = soc2bd_remote_network.n_dg3.id
+
+	  access {
+	    group_ids = [soc2bd_group.g_dg3.id]
+	  }
+	}
+
+	data "soc2bd_group" "out_dg3" {
+	  id = soc2bd_group.g_dg3.id
+	  depends_on = [soc2bd_resource.r1_dg3, soc2bd_resource.r2_dg3]
+	}
+	`, name, email1, email2)
+}
+
 func TestAccDatasourceSoc2bdGroup_negative(t *testing.T) {
 	t.Run("Test Soc2bd Datasource : Acc Group - does not exists", func(t *testing.T) {
 		groupID := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("Group:%d", acctest.RandInt())))
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
 			PreCheck: func() {
 				acctests.PreCheck(t)
 			},
@@ -103,7 +180,7 @@ func TestAccDatasourceSoc2bdGroup_invalidGroupID(t *testing.T) {
 		groupID := acctest.RandString(10)
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
 			PreCheck: func() {
 				acctests.PreCheck(t)
 			},
@@ -31,9 +31,9 @@ func TestAccDatasourceSoc2bdGroups_basic(t *testing.T) {
 		testPolicy := securityPolicies[0]
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdGroupDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdGroupDestroy,
 			Steps: []resource.TestStep{
 				{
 					Config: testDatasourceSoc2bdGroups(groupName, testPolicy.ID),
@@ -73,8 +73,8 @@ func TestAccDatasourceSoc2bdGroups_emptyResult(t *testing.T) {
 		groupName := test.RandomName()
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
 			Steps: []resource.TestStep{
 				{
 					Config: testSoc2bdGroupsDoesNotExists(groupName),
@@ -103,8 +103,8 @@ func TestAccDatasourceSoc2bdGroupsWithFilters_basic(t *testing.T) {
 
 	t.Run("Test Soc2bd Datasource : Acc Groups with filters - basic", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
 			Steps: []resource.TestStep{
 				{
 					Config: testDatasourceSoc2bdGroupsWithFilters(groupName),
@@ -141,7 +141,7 @@ func testDatasourceSoc2bdGroupsWithFilters(name string) string {
 func TestAccDatasourceSoc2bdGroupsWithFilters_ErrorNotSupportedTypes(t *testing.T) {
 	t.Run("Test Soc2bd Datasource : Acc Groups with filters - error not supported types", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
 			PreCheck: func() {
 				acctests.PreCheck(t)
 			},
@@ -170,7 +170,7 @@ func testSoc2bdGroupsWithFilterNotSupportedType() string {
 func TestAccDatasourceSoc2bdGroups_WithEmptyFilters(t *testing.T) {
 	t.Run("Test Soc2bd Datasource : Acc Groups - with empty filters", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
 			PreCheck: func() {
 				acctests.PreCheck(t)
 			},
@@ -199,9 +199,9 @@ func TestAccDatasourceSoc2bdGroups_withTwoDatasource(t *testing.T) {
 		groupName := test.RandomName()
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdGroupDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdGroupDestroy,
 			Steps: []resource.TestStep{
 				{
 					Config: testDatasourceSoc2bdGroupsWithDatasource(groupName),
@@ -19,9 +19,9 @@ func TestAccDatasourceSoc2bdRemoteNetwork_basic(t *testing.T) {
 		networkName := test.RandomName()
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdRemoteNetworkDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdRemoteNetworkDestroy,
 			Steps: []resource.TestStep{
 				{
 					Config: testDatasourceSoc2bdRemoteNetwork(networkName),
@@ -56,9 +56,9 @@ func TestAccDatasourceSoc2bdRemoteNetworkByName_basic(t *testing.T) {
 		networkName := test.RandomName()
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdRemoteNetworkDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdRemoteNetworkDestroy,
 			Steps: []resource.TestStep{
 				{
 					Config: testDatasourceSoc2bdRemoteNetworkByName(networkName),
@@ -93,8 +93,8 @@ func TestAccDatasourceSoc2bdRemoteNetwork_negative(t *testing.T) {
 		networkID := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("RemoteNetwork:%d", acctest.RandInt())))
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
 			Steps: []resource.TestStep{
 				{
 					Config:      testSoc2bdRemoteNetworkDoesNotExists(networkID),
@@ -122,8 +122,8 @@ func TestAccDatasourceSoc2bdRemoteNetwork_invalidNetworkID(t *testing.T) {
 		networkID := acctest.RandString(10)
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
 			Steps: []resource.TestStep{
 				{
 					Config:      testSoc2bdRemoteNetworkDoesNotExists(networkID),
@@ -140,7 +140,7 @@ func TestAccDatasourceSoc2bdRemoteNetwork_bothNetworkIDAndName(t *testing.T) {
 		networkName := acctest.RandString(10)
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
 			PreCheck: func() {
 				acctests.PreCheck(t)
 			},
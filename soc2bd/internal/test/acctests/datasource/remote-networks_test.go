@@ -19,9 +19,9 @@ func TestAccDatasourceSoc2bdRemoteNetworks_read(t *testing.T) {
 		networkName2 := test.RandomName(prefix)
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdRemoteNetworkDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdRemoteNetworkDestroy,
 			Steps: []resource.TestStep{
 				{
 					Config: testDatasourceSoc2bdRemoteNetworks2(networkName1, networkName2, prefix),
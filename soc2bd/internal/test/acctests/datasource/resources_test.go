@@ -23,9 +23,9 @@ func TestAccDatasourceSoc2bdResources_basic(t *testing.T) {
 		const theDatasource = "data.soc2bd_resources.out_drs1"
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 			Steps: []resource.TestStep{
 				{
 					Config: testDatasourceSoc2bdResources(networkName, resourceName),
@@ -92,7 +92,7 @@ func TestAccDatasourceSoc2bdResources_emptyResult(t *testing.T) {
 		resourceName := test.RandomResourceName()
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
 			PreCheck: func() {
 				acctests.PreCheck(t)
 			},
@@ -119,3 +119,102 @@ func testSoc2bdResourcesDoesNotExists(name string) string {
 	}
 	`, name)
 }
+
+func TestAccDatasourceSoc2bdResources_filters(t *testing.T) {
+	t.Run("Test Soc2bd Datasource : Acc Resources Filters", func(t *testing.T) {
+		networkName := test.RandomName()
+		prefix := test.RandomResourceName()
+		const (
+			byPrefix  = "data.soc2bd_resources.out_drs3_prefix"
+			byCIDR    = "data.soc2bd_resources.out_drs3_cidr"
+			byPolicy  = "data.soc2bd_resources.out_drs3_policy"
+			byGroup   = "data.soc2bd_resources.out_drs3_group"
+			byNetwork = "data.soc2bd_resources.out_drs3_network"
+		)
+
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: testDatasourceSoc2bdResourcesFilters(networkName, prefix),
+					Check: acctests.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(byPrefix, resourcesLen, "2"),
+						resource.TestCheckResourceAttr(byCIDR, resourcesLen, "1"),
+						resource.TestCheckResourceAttr(byCIDR, resourceNamePath, prefix+"-ip"),
+						resource.TestCheckResourceAttr(byPolicy, resourcesLen, "1"),
+						resource.TestCheckResourceAttr(byPolicy, resourceNamePath, prefix+"-fqdn"),
+						resource.TestCheckResourceAttr(byGroup, resourcesLen, "1"),
+						resource.TestCheckResourceAttr(byGroup, resourceNamePath, prefix+"-ip"),
+						resource.TestCheckResourceAttr(byNetwork, resourcesLen, "2"),
+					),
+				},
+			},
+		})
+	})
+}
+
+func testDatasourceSoc2bdResourcesFilters(networkName, prefix string) string {
+	return fmt.Sprintf(`
+	resource "soc2bd_remote_network" "test_drs3" {
+	  name = "%[1]s"
+	}
+
+	resource "soc2bd_group" "test_drs3" {
+	  name = "%[2]s"
+	}
+
+	resource "soc2bd_resource" "test_drs3_ip" {
+	  name = "%[2]s-ip"
+	  address = "10.20.0.5"
+	  remote_network_id = soc2bd_remote_network.test_drs3.id
+
+	  access {
+	    group_ids = [soc2bd_group.test_drs3.id]
+	  }
+	}
+
+	resource "soc2bd_resource" "test_drs3_fqdn" {
+	  name = "%[2]s-fqdn"
+	  address = "acc-test.com"
+	  remote_network_id = soc2bd_remote_network.test_drs3.id
+	  protocols {
+	    allow_icmp = true
+	    tcp {
+	      policy = "DENY_ALL"
+	    }
+	    udp {
+	      policy = "DENY_ALL"
+	    }
+	  }
+	}
+
+	data "soc2bd_resources" "out_drs3_prefix" {
+	  name_prefix = "%[2]s-"
+	  depends_on = [soc2bd_resource.test_drs3_ip, soc2bd_resource.test_drs3_fqdn]
+	}
+
+	data "soc2bd_resources" "out_drs3_cidr" {
+	  name_prefix = "%[2]s-"
+	  address_within_cidr = "10.20.0.0/16"
+	  depends_on = [soc2bd_resource.test_drs3_ip, soc2bd_resource.test_drs3_fqdn]
+	}
+
+	data "soc2bd_resources" "out_drs3_policy" {
+	  name_regexp = "^%[2]s-.*$"
+	  protocol_policy = "DENY_ALL"
+	  depends_on = [soc2bd_resource.test_drs3_ip, soc2bd_resource.test_drs3_fqdn]
+	}
+
+	data "soc2bd_resources" "out_drs3_group" {
+	  group_id = soc2bd_group.test_drs3.id
+	  depends_on = [soc2bd_resource.test_drs3_ip, soc2bd_resource.test_drs3_fqdn]
+	}
+
+	data "soc2bd_resources" "out_drs3_network" {
+	  remote_network_id = soc2bd_remote_network.test_drs3.id
+	  depends_on = [soc2bd_resource.test_drs3_ip, soc2bd_resource.test_drs3_fqdn]
+	}
+	`, networkName, prefix)
+}
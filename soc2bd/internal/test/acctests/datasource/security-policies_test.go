@@ -19,8 +19,8 @@ func TestAccDatasourceSoc2bdSecurityPoliciesBasic(t *testing.T) {
 		}
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
 			Steps: []sdk.TestStep{
 				{
 					Config: testDatasourceSoc2bdSecurityPolicies(),
@@ -39,9 +39,9 @@ func TestAccDatasourceSoc2bdServicesFilterByName(t *testing.T) {
 		}
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdServiceAccountDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdServiceAccountDestroy,
 			Steps: []resource.TestStep{
 				{
 					Config: terraformConfig(
@@ -79,9 +79,9 @@ func TestAccDatasourceSoc2bdServicesAll(t *testing.T) {
 		}
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdServiceAccountDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdServiceAccountDestroy,
 			Steps: []resource.TestStep{
 				{
 					Config: filterDatasourceServices(prefix, config),
@@ -106,9 +106,9 @@ func TestAccDatasourceSoc2bdServicesEmptyResult(t *testing.T) {
 		const theDatasource = "data.soc2bd_service_accounts.out"
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdServiceAccountDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdServiceAccountDestroy,
 			Steps: []resource.TestStep{
 				{
 					Config: datasourceServices(test.RandomName(), nil),
@@ -205,9 +205,9 @@ func TestAccDatasourceSoc2bdServicesAllCursors(t *testing.T) {
 		)
 
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdServiceAccountDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdServiceAccountDestroy,
 			Steps: []resource.TestStep{
 				{
 					Config: datasourceServicesConfig(prefix),
@@ -2,9 +2,11 @@ package datasource
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test/acctests"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -14,8 +16,8 @@ func TestAccDatasourceSoc2bdUsers_basic(t *testing.T) {
 	t.Run("Test Soc2bd Datasource : Acc Users Basic", func(t *testing.T) {
 		acctests.SetPageLimit(1)
 		resource.Test(t, resource.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
 			Steps: []resource.TestStep{
 				{
 					Config: testDatasourceSoc2bdUsers(),
@@ -34,6 +36,71 @@ func testDatasourceSoc2bdUsers() string {
 	`
 }
 
+func TestAccDatasourceSoc2bdUsers_filters(t *testing.T) {
+	t.Run("Test Soc2bd Datasource : Acc Users Filters", func(t *testing.T) {
+		email := test.RandomEmail()
+		groupName := test.RandomGroupName()
+		theDatasource := "data.soc2bd_users.filtered"
+
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			Steps: []resource.TestStep{
+				{
+					Config: testDatasourceSoc2bdUsersFilters(email, groupName),
+					Check: acctests.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(theDatasource, attr.Len(attr.Users), "1"),
+						resource.TestCheckResourceAttr(theDatasource, attr.Path(attr.Users, attr.Email), email),
+						resource.TestCheckResourceAttr(theDatasource, attr.Len(attr.Users, attr.GroupIDs), "1"),
+						resource.TestCheckTypeSetElemAttrPair(theDatasource, attr.Path(attr.Users, attr.GroupIDs)+".*", "soc2bd_group.g_users", attr.ID),
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccDatasourceSoc2bdUsers_invalidRole(t *testing.T) {
+	t.Run("Test Soc2bd Datasource : Acc Users Invalid Role", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					data "soc2bd_users" "invalid" {
+					  role = "OWNER"
+					}
+					`,
+					ExpectError: regexp.MustCompile(`expected role to be one of`),
+				},
+			},
+		})
+	})
+}
+
+func testDatasourceSoc2bdUsersFilters(email, groupName string) string {
+	return fmt.Sprintf(`
+	resource "soc2bd_user" "u_users" {
+	  email = "%s"
+	  role = "DEVOPS"
+	  send_invite = false
+	}
+
+	resource "soc2bd_group" "g_users" {
+	  name = "%s"
+	  user_ids = [soc2bd_user.u_users.id]
+	}
+
+	data "soc2bd_users" "filtered" {
+	  role = "DEVOPS"
+	  email_regexp = "^%s$"
+
+	  depends_on = [soc2bd_group.g_users]
+	}
+	`, email, groupName, regexp.QuoteMeta(email))
+}
+
 func testCheckResourceAttrNotEqual(name, key, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		ms := s.RootModule()
@@ -7,14 +7,18 @@ import (
 	"log"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider/resource"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test/fake"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/utils"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -42,16 +46,22 @@ func ErrUsersLenMismatch(expected, actual int) error {
 	return fmt.Errorf("expected %d users, actual - %d", expected, actual) //nolint
 }
 
-var Provider *schema.Provider                                     //nolint:gochecknoglobals
-var ProviderFactories map[string]func() (*schema.Provider, error) //nolint:gochecknoglobals
+var Provider *schema.Provider                                             //nolint:gochecknoglobals
+var ProviderFactories map[string]func() (tfprotov6.ProviderServer, error) //nolint:gochecknoglobals
 
 //nolint:gochecknoinits
 func init() {
 	Provider = soc2bd.Provider("test")
 
-	ProviderFactories = map[string]func() (*schema.Provider, error){
-		"soc2bd": func() (*schema.Provider, error) {
-			return Provider, nil
+	// the SDK provider is muxed with the plugin framework one, Provider is kept to access the configured client
+	ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+		"soc2bd": func() (tfprotov6.ProviderServer, error) {
+			serverFactory, err := soc2bd.ProviderServerFactory(context.Background(), Provider, "test")
+			if err != nil {
+				return nil, err //nolint:wrapcheck
+			}
+
+			return serverFactory(), nil
 		},
 	}
 }
@@ -89,9 +99,33 @@ func ComposeTestCheckFunc(checkFuncs ...sdk.TestCheckFunc) sdk.TestCheckFunc {
 	}
 }
 
+// EnvOffline enables running acceptance tests against the in-memory fake server.
+const EnvOffline = "SOC2BD_ACC_OFFLINE"
+
+var (
+	fakeServer     *fake.Server //nolint:gochecknoglobals
+	fakeServerOnce sync.Once    //nolint:gochecknoglobals
+)
+
+func startFakeServer(t *testing.T) {
+	t.Helper()
+
+	fakeServerOnce.Do(func() {
+		fakeServer = fake.NewServer()
+	})
+
+	if err := fakeServer.Setenv(); err != nil {
+		t.Fatalf("failed to configure fake server: %v", err)
+	}
+}
+
 func PreCheck(t *testing.T) {
 	t.Helper()
 
+	if os.Getenv(EnvOffline) != "" {
+		startFakeServer(t)
+	}
+
 	var requiredEnvironmentVariables = []string{
 		soc2bd.EnvAPIToken,
 		soc2bd.EnvNetwork,
@@ -119,7 +153,7 @@ func CheckSoc2bdResourceDoesNotExists(resourceName string) sdk.TestCheckFunc {
 }
 
 func CheckSoc2bdServiceAccountDestroy(s *terraform.State) error {
-	providerClient := Provider.Meta().(*client.Client)
+	providerClient := Provider.Meta().(*provider.Meta).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != resource.Soc2bdServiceAccount {
@@ -161,6 +195,10 @@ func TerraformResource(name string) string {
 	return ResourceName(resource.Soc2bdResource, name)
 }
 
+func TerraformResourceAccess(name string) string {
+	return ResourceName(resource.Soc2bdResourceAccess, name)
+}
+
 func TerraformRemoteNetwork(name string) string {
 	return ResourceName(resource.Soc2bdRemoteNetwork, name)
 }
@@ -169,6 +207,14 @@ func TerraformGroup(name string) string {
 	return ResourceName(resource.Soc2bdGroup, name)
 }
 
+func TerraformGroupMembership(name string) string {
+	return ResourceName(resource.Soc2bdGroupMembership, name)
+}
+
+func TerraformSecurityPolicy(name string) string {
+	return ResourceName(resource.Soc2bdSecurityPolicy, name)
+}
+
 func TerraformConnector(name string) string {
 	return ResourceName(resource.Soc2bdConnector, name)
 }
@@ -213,7 +259,7 @@ func DeleteSoc2bdResource(resourceName, resourceType string) sdk.TestCheckFunc {
 func deleteResource(resourceType, resourceID string) error {
 	var err error
 
-	providerClient := Provider.Meta().(*client.Client)
+	providerClient := Provider.Meta().(*provider.Meta).Client
 
 	switch resourceType {
 	case resource.Soc2bdResource:
@@ -238,7 +284,7 @@ func deleteResource(resourceType, resourceID string) error {
 }
 
 func CheckSoc2bdResourceDestroy(s *terraform.State) error {
-	providerClient := Provider.Meta().(*client.Client)
+	providerClient := Provider.Meta().(*provider.Meta).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != resource.Soc2bdResource {
@@ -257,9 +303,38 @@ func CheckSoc2bdResourceDestroy(s *terraform.State) error {
 	return nil
 }
 
+// CheckSoc2bdResourceDeactivated checks the resources were deactivated instead of deleted on destroy,
+// and deletes them.
+func CheckSoc2bdResourceDeactivated(s *terraform.State) error {
+	providerClient := Provider.Meta().(*provider.Meta).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resource.Soc2bdResource {
+			continue
+		}
+
+		resourceID := rs.Primary.ID
+
+		res, err := providerClient.ReadResource(context.Background(), resourceID)
+		if err != nil {
+			return fmt.Errorf("failed to read resource: %w", err)
+		}
+
+		if res.IsActive {
+			return fmt.Errorf("resource with ID %s still active", resourceID) //nolint:goerr113
+		}
+
+		if err := providerClient.DeleteResource(context.Background(), resourceID); err != nil {
+			return fmt.Errorf("failed to delete resource: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func DeactivateSoc2bdResource(resourceName string) sdk.TestCheckFunc {
 	return func(s *terraform.State) error {
-		providerClient := Provider.Meta().(*client.Client)
+		providerClient := Provider.Meta().(*provider.Meta).Client
 
 		resourceState, ok := s.RootModule().Resources[resourceName]
 
@@ -288,7 +363,7 @@ func DeactivateSoc2bdResource(resourceName string) sdk.TestCheckFunc {
 
 func CheckSoc2bdResourceActiveState(resourceName string, expectedActiveState bool) sdk.TestCheckFunc {
 	return func(s *terraform.State) error {
-		providerClient := Provider.Meta().(*client.Client)
+		providerClient := Provider.Meta().(*provider.Meta).Client
 
 		resourceState, ok := s.RootModule().Resources[resourceName]
 
@@ -331,7 +406,7 @@ func CheckImportState(attributes map[string]string) func(data []*terraform.Insta
 }
 
 func CheckSoc2bdRemoteNetworkDestroy(s *terraform.State) error {
-	providerClient := Provider.Meta().(*client.Client)
+	providerClient := Provider.Meta().(*provider.Meta).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != resource.Soc2bdRemoteNetwork {
@@ -351,7 +426,7 @@ func CheckSoc2bdRemoteNetworkDestroy(s *terraform.State) error {
 }
 
 func CheckSoc2bdGroupDestroy(s *terraform.State) error {
-	providerClient := Provider.Meta().(*client.Client)
+	providerClient := Provider.Meta().(*provider.Meta).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != resource.Soc2bdGroup {
@@ -369,8 +444,27 @@ func CheckSoc2bdGroupDestroy(s *terraform.State) error {
 	return nil
 }
 
+func CheckSoc2bdSecurityPolicyDestroy(s *terraform.State) error {
+	providerClient := Provider.Meta().(*provider.Meta).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resource.Soc2bdSecurityPolicy {
+			continue
+		}
+
+		policyID := rs.Primary.ID
+
+		err := providerClient.DeleteSecurityPolicy(context.Background(), policyID)
+		if err == nil {
+			return fmt.Errorf("%w with ID %s", ErrResourceStillPresent, policyID)
+		}
+	}
+
+	return nil
+}
+
 func CheckSoc2bdConnectorDestroy(s *terraform.State) error {
-	providerClient := Provider.Meta().(*client.Client)
+	providerClient := Provider.Meta().(*provider.Meta).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != resource.Soc2bdConnector {
@@ -401,7 +495,7 @@ func RevokeSoc2bdServiceKey(resourceName string) sdk.TestCheckFunc {
 			return ErrResourceIDNotSet
 		}
 
-		client := Provider.Meta().(*client.Client)
+		client := Provider.Meta().(*provider.Meta).Client
 
 		err := client.RevokeServiceKey(context.Background(), resourceID)
 		if err != nil {
@@ -412,6 +506,30 @@ func RevokeSoc2bdServiceKey(resourceName string) sdk.TestCheckFunc {
 	}
 }
 
+func InvalidateSoc2bdConnectorTokens(resourceName string) sdk.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resourceState, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrResourceNotFound, resourceName)
+		}
+
+		connectorID := resourceState.Primary.ID
+		if connectorID == "" {
+			return ErrResourceIDNotSet
+		}
+
+		client := Provider.Meta().(*provider.Meta).Client
+
+		// generating new tokens invalidates the ones in state
+		_, err := client.GenerateConnectorTokens(context.Background(), connectorID)
+		if err != nil {
+			return fmt.Errorf("failed to invalidate connector tokens with ID %s: %w", connectorID, err)
+		}
+
+		return nil
+	}
+}
+
 func CheckSoc2bdServiceKeyStatus(resourceName string, expectedStatus string) sdk.TestCheckFunc {
 	return func(s *terraform.State) error {
 		resourceState, ok := s.RootModule().Resources[resourceName]
@@ -423,7 +541,7 @@ func CheckSoc2bdServiceKeyStatus(resourceName string, expectedStatus string) sdk
 			return ErrResourceIDNotSet
 		}
 
-		client := Provider.Meta().(*client.Client)
+		client := Provider.Meta().(*provider.Meta).Client
 
 		serviceAccountKey, err := client.ReadServiceKey(context.Background(), resourceState.Primary.ID)
 		if err != nil {
@@ -443,7 +561,7 @@ func ListSecurityPolicies() ([]*model.SecurityPolicy, error) {
 		return nil, ErrClientNotInited
 	}
 
-	client := Provider.Meta().(*client.Client)
+	client := Provider.Meta().(*provider.Meta).Client
 
 	securityPolicies, err := client.ReadSecurityPolicies(context.Background())
 	if err != nil {
@@ -459,7 +577,7 @@ func ListSecurityPolicies() ([]*model.SecurityPolicy, error) {
 
 func AddResourceGroup(resourceName, groupName string) sdk.TestCheckFunc {
 	return func(state *terraform.State) error {
-		providerClient := Provider.Meta().(*client.Client)
+		providerClient := Provider.Meta().(*provider.Meta).Client
 
 		resourceID, err := getResourceID(state, resourceName)
 		if err != nil {
@@ -485,7 +603,7 @@ func AddResourceGroup(resourceName, groupName string) sdk.TestCheckFunc {
 
 func DeleteResourceGroup(resourceName, groupName string) sdk.TestCheckFunc {
 	return func(state *terraform.State) error {
-		providerClient := Provider.Meta().(*client.Client)
+		providerClient := Provider.Meta().(*provider.Meta).Client
 
 		resourceID, err := getResourceID(state, resourceName)
 		if err != nil {
@@ -508,7 +626,7 @@ func DeleteResourceGroup(resourceName, groupName string) sdk.TestCheckFunc {
 
 func CheckResourceGroupsLen(resourceName string, expectedGroupsLen int) sdk.TestCheckFunc {
 	return func(state *terraform.State) error {
-		providerClient := Provider.Meta().(*client.Client)
+		providerClient := Provider.Meta().(*provider.Meta).Client
 
 		resourceID, err := getResourceID(state, resourceName)
 		if err != nil {
@@ -546,7 +664,7 @@ func getResourceID(s *terraform.State, resourceName string) (string, error) {
 
 func AddResourceServiceAccount(resourceName, serviceAccountName string) sdk.TestCheckFunc {
 	return func(state *terraform.State) error {
-		providerClient := Provider.Meta().(*client.Client)
+		providerClient := Provider.Meta().(*provider.Meta).Client
 
 		resourceID, err := getResourceID(state, resourceName)
 		if err != nil {
@@ -572,7 +690,7 @@ func AddResourceServiceAccount(resourceName, serviceAccountName string) sdk.Test
 
 func DeleteResourceServiceAccount(resourceName, serviceAccountName string) sdk.TestCheckFunc {
 	return func(state *terraform.State) error {
-		providerClient := Provider.Meta().(*client.Client)
+		providerClient := Provider.Meta().(*provider.Meta).Client
 
 		resourceID, err := getResourceID(state, resourceName)
 		if err != nil {
@@ -595,7 +713,7 @@ func DeleteResourceServiceAccount(resourceName, serviceAccountName string) sdk.T
 
 func CheckResourceServiceAccountsLen(resourceName string, expectedServiceAccountsLen int) sdk.TestCheckFunc {
 	return func(state *terraform.State) error {
-		providerClient := Provider.Meta().(*client.Client)
+		providerClient := Provider.Meta().(*provider.Meta).Client
 
 		resourceID, err := getResourceID(state, resourceName)
 		if err != nil {
@@ -632,7 +750,7 @@ func CheckResourceServiceAccountsLen(resourceName string, expectedServiceAccount
 
 func AddGroupUser(groupResource, groupName, terraformUserID string) sdk.TestCheckFunc {
 	return func(state *terraform.State) error {
-		providerClient := Provider.Meta().(*client.Client)
+		providerClient := Provider.Meta().(*provider.Meta).Client
 
 		userID, err := getResourceID(state, getResourceNameFromID(terraformUserID))
 		if err != nil {
@@ -663,7 +781,7 @@ func getResourceNameFromID(terraformID string) string {
 
 func DeleteGroupUser(groupResource, terraformUserID string) sdk.TestCheckFunc {
 	return func(state *terraform.State) error {
-		providerClient := Provider.Meta().(*client.Client)
+		providerClient := Provider.Meta().(*provider.Meta).Client
 
 		userID, err := getResourceID(state, getResourceNameFromID(terraformUserID))
 		if err != nil {
@@ -686,7 +804,7 @@ func DeleteGroupUser(groupResource, terraformUserID string) sdk.TestCheckFunc {
 
 func CheckGroupUsersLen(resourceName string, expectedUsersLen int) sdk.TestCheckFunc {
 	return func(state *terraform.State) error {
-		providerClient := Provider.Meta().(*client.Client)
+		providerClient := Provider.Meta().(*provider.Meta).Client
 
 		groupID, err := getResourceID(state, resourceName)
 		if err != nil {
@@ -711,7 +829,7 @@ func GetTestUsers() ([]*model.User, error) {
 		return nil, ErrClientNotInited
 	}
 
-	client := Provider.Meta().(*client.Client)
+	client := Provider.Meta().(*provider.Meta).Client
 
 	users, err := client.ReadUsers(context.Background())
 	if err != nil {
@@ -726,7 +844,7 @@ func GetTestUsers() ([]*model.User, error) {
 }
 
 func CheckSoc2bdUserDestroy(s *terraform.State) error {
-	providerClient := Provider.Meta().(*client.Client)
+	providerClient := Provider.Meta().(*provider.Meta).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != resource.Soc2bdUser {
@@ -1,7 +1,21 @@
 package acctests
 
 import (
+	"context"
 	"testing"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider/resource"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test/fake"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestProvider(t *testing.T) {
@@ -11,3 +25,332 @@ func TestProvider(t *testing.T) {
 		}
 	})
 }
+
+func newProviderServer(t *testing.T) tfprotov6.ProviderServer {
+	t.Helper()
+
+	server, err := ProviderFactories["soc2bd"]()
+	require.NoError(t, err)
+
+	return server
+}
+
+func TestProviderServerSchema(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Provider Server Schema", func(t *testing.T) {
+		resp, err := newProviderServer(t).GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+		require.NoError(t, err)
+
+		// the muxed providers must have identical provider schemas
+		assert.Empty(t, resp.Diagnostics)
+
+		resourceSchema, ok := resp.ResourceSchemas[resource.Soc2bdResource]
+		require.True(t, ok)
+		assert.EqualValues(t, 1, resourceSchema.Version)
+
+		// the SDK implementation attribute types are unchanged, states it wrote can be read
+		portsType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			attr.Policy: tftypes.String,
+			attr.Ports:  tftypes.List{ElementType: tftypes.String},
+		}}
+		expectedType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			attr.ID:                       tftypes.String,
+			attr.Name:                     tftypes.String,
+			attr.Address:                  tftypes.String,
+			attr.AddressType:              tftypes.String,
+			attr.RemoteNetworkID:          tftypes.String,
+			attr.IsAuthoritative:          tftypes.Bool,
+			attr.IsVisible:                tftypes.Bool,
+			attr.IsBrowserShortcutEnabled: tftypes.Bool,
+			attr.Alias:                    tftypes.String,
+			attr.IsActive:                 tftypes.Bool,
+			attr.DeactivateOnDestroy:      tftypes.Bool,
+			attr.DeletionProtection:       tftypes.Bool,
+			attr.Protocols: tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				attr.AllowIcmp: tftypes.Bool,
+				attr.TCP:       tftypes.List{ElementType: portsType},
+				attr.UDP:       tftypes.List{ElementType: portsType},
+			}}},
+			attr.Access: tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				attr.GroupIDs:          tftypes.Set{ElementType: tftypes.String},
+				attr.ServiceAccountIDs: tftypes.Set{ElementType: tftypes.String},
+			}}},
+		}}
+
+		assert.True(t, expectedType.Equal(resourceSchema.ValueType()), "unexpected type %s", resourceSchema.ValueType())
+
+		for _, name := range []string{resource.Soc2bdGroup, resource.Soc2bdRemoteNetwork, resource.Soc2bdConnectorTokens} {
+			assert.Contains(t, resp.ResourceSchemas, name)
+		}
+	})
+}
+
+func upgradeResourceState(t *testing.T, rawState string) map[string]tftypes.Value {
+	t.Helper()
+
+	server := newProviderServer(t)
+	ctx := context.Background()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: resource.Soc2bdResource,
+		Version:  0,
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+
+	value, err := resp.UpgradedState.Unmarshal(schemaResp.ResourceSchemas[resource.Soc2bdResource].ValueType())
+	require.NoError(t, err)
+
+	var state map[string]tftypes.Value
+	require.NoError(t, value.As(&state))
+
+	return state
+}
+
+func TestResourceUpgradeState(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Upgrade SDK State With Default Protocols", func(t *testing.T) {
+		state := upgradeResourceState(t, `{
+			"id": "resource-id", "name": "test", "address": "acc-test.com", "remote_network_id": "network-id",
+			"is_authoritative": true, "is_visible": true, "is_browser_shortcut_enabled": false, "alias": null,
+			"access": [{"group_ids": ["group-id"], "service_account_ids": []}],
+			"protocols": [{"allow_icmp": true, "tcp": [{"policy": "ALLOW_ALL", "ports": []}], "udp": [{"policy": "ALLOW_ALL", "ports": []}]}]
+		}`)
+
+		assert.Equal(t, tftypes.NewValue(tftypes.String, "resource-id"), state[attr.ID])
+
+		var protocols []tftypes.Value
+		require.NoError(t, state[attr.Protocols].As(&protocols))
+		assert.Empty(t, protocols)
+	})
+
+	t.Run("Test Soc2bd Resource : Upgrade SDK State With Deny All Policy", func(t *testing.T) {
+		state := upgradeResourceState(t, `{
+			"id": "resource-id", "name": "test", "address": "acc-test.com", "remote_network_id": "network-id",
+			"is_authoritative": true, "is_visible": true, "is_browser_shortcut_enabled": false, "alias": null,
+			"access": [],
+			"protocols": [{"allow_icmp": true, "tcp": [{"policy": "RESTRICTED", "ports": ["80"]}], "udp": [{"policy": "RESTRICTED", "ports": []}]}]
+		}`)
+
+		var protocols []tftypes.Value
+		require.NoError(t, state[attr.Protocols].As(&protocols))
+		require.Len(t, protocols, 1)
+
+		var protocolsAttrs map[string]tftypes.Value
+		require.NoError(t, protocols[0].As(&protocolsAttrs))
+
+		assert.Equal(t, model.PolicyRestricted, policy(t, protocolsAttrs[attr.TCP]))
+		assert.Equal(t, model.PolicyDenyAll, policy(t, protocolsAttrs[attr.UDP]))
+	})
+}
+
+func policy(t *testing.T, protocol tftypes.Value) string {
+	t.Helper()
+
+	var list []tftypes.Value
+	require.NoError(t, protocol.As(&list))
+	require.Len(t, list, 1)
+
+	var attrs map[string]tftypes.Value
+	require.NoError(t, list[0].As(&attrs))
+
+	var value string
+	require.NoError(t, attrs[attr.Policy].As(&value))
+
+	return value
+}
+
+func dynamicValue(t *testing.T, typ tftypes.Type, value tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	dynamic, err := tfprotov6.NewDynamicValue(typ, value)
+	require.NoError(t, err)
+
+	return &dynamic
+}
+
+func TestResourceServerLifecycle(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Resource Server Lifecycle", func(t *testing.T) {
+		srv := fake.NewServer()
+		t.Cleanup(srv.Close)
+
+		t.Setenv(soc2bd.EnvURL, srv.URL)
+		t.Setenv(soc2bd.EnvNetwork, fake.Network)
+		t.Setenv(soc2bd.EnvAPIToken, fake.APIToken)
+
+		ctx := context.Background()
+		c := client.NewClient(client.WithURL(srv.URL), client.WithAPIToken(fake.APIToken), client.WithNetwork(fake.Network))
+
+		network, err := c.CreateRemoteNetwork(ctx, &model.RemoteNetwork{Name: "network"})
+		require.NoError(t, err)
+
+		group, err := c.CreateGroup(ctx, &model.Group{Name: "group"})
+		require.NoError(t, err)
+
+		server := newProviderServer(t)
+
+		schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+		require.NoError(t, err)
+
+		providerType := schemaResp.Provider.ValueType()
+		configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+			Config: dynamicValue(t, providerType, tftypes.NewValue(providerType, nil)),
+		})
+		require.NoError(t, err)
+		require.Empty(t, configureResp.Diagnostics)
+
+		resourceType := schemaResp.ResourceSchemas[resource.Soc2bdResource].ValueType()
+		config, err := tftypes.ValueFromJSON([]byte(`{
+			"name": "resource", "address": "10.0.0.1", "remote_network_id": "`+network.ID+`",
+			"access": [{"group_ids": ["`+group.ID+`"]}],
+			"protocols": [{"tcp": [{"policy": "RESTRICTED", "ports": ["80", "81"]}], "udp": [{"policy": "DENY_ALL"}]}]
+		}`), resourceType)
+		require.NoError(t, err)
+
+		nullState := dynamicValue(t, resourceType, tftypes.NewValue(resourceType, nil))
+
+		planResp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+			TypeName:         resource.Soc2bdResource,
+			PriorState:       nullState,
+			ProposedNewState: dynamicValue(t, resourceType, config),
+			Config:           dynamicValue(t, resourceType, config),
+		})
+		require.NoError(t, err)
+		require.Empty(t, planResp.Diagnostics)
+
+		applyResp, err := server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+			TypeName:     resource.Soc2bdResource,
+			PriorState:   nullState,
+			PlannedState: planResp.PlannedState,
+			Config:       dynamicValue(t, resourceType, config),
+		})
+		require.NoError(t, err)
+		require.Empty(t, applyResp.Diagnostics)
+
+		newState, err := applyResp.NewState.Unmarshal(resourceType)
+		require.NoError(t, err)
+
+		// the ports and policies are kept as configured, the defaults are set
+		var state map[string]tftypes.Value
+		require.NoError(t, newState.As(&state))
+		assert.True(t, state[attr.ID].IsKnown() && !state[attr.ID].IsNull())
+		assert.Equal(t, tftypes.NewValue(tftypes.Bool, true), state[attr.IsAuthoritative])
+		assert.True(t, state[attr.IsVisible].IsKnown() && !state[attr.IsVisible].IsNull())
+
+		expectedProtocols, err := tftypes.ValueFromJSON([]byte(`{"protocols": [{"allow_icmp": true,
+			"tcp": [{"policy": "RESTRICTED", "ports": ["80", "81"]}], "udp": [{"policy": "DENY_ALL", "ports": null}]}]}`),
+			tftypes.Object{AttributeTypes: map[string]tftypes.Type{attr.Protocols: resourceType.(tftypes.Object).AttributeTypes[attr.Protocols]}})
+		require.NoError(t, err)
+
+		var expected map[string]tftypes.Value
+		require.NoError(t, expectedProtocols.As(&expected))
+		assert.True(t, expected[attr.Protocols].Equal(state[attr.Protocols]), "unexpected protocols %s", state[attr.Protocols])
+
+		// reading and planning again shows no changes
+		readResp, err := server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+			TypeName:     resource.Soc2bdResource,
+			CurrentState: applyResp.NewState,
+		})
+		require.NoError(t, err)
+		require.Empty(t, readResp.Diagnostics)
+
+		readState, err := readResp.NewState.Unmarshal(resourceType)
+		require.NoError(t, err)
+		assert.True(t, newState.Equal(readState), "unexpected state after read %s", readState)
+
+		planResp, err = server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+			TypeName:         resource.Soc2bdResource,
+			PriorState:       readResp.NewState,
+			ProposedNewState: readResp.NewState,
+			Config:           dynamicValue(t, resourceType, config),
+		})
+		require.NoError(t, err)
+		require.Empty(t, planResp.Diagnostics)
+
+		plannedState, err := planResp.PlannedState.Unmarshal(resourceType)
+		require.NoError(t, err)
+		assert.True(t, newState.Equal(plannedState), "unexpected plan %s", plannedState)
+	})
+}
+
+func TestProviderConfigureTransport(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Provider Configure Invalid Proxy URL", func(t *testing.T) {
+		t.Setenv(soc2bd.EnvNetwork, fake.Network)
+		t.Setenv(soc2bd.EnvAPIToken, fake.APIToken)
+
+		ctx := context.Background()
+		server := newProviderServer(t)
+
+		schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+		require.NoError(t, err)
+
+		providerType := schemaResp.Provider.ValueType().(tftypes.Object)
+		values := make(map[string]tftypes.Value, len(providerType.AttributeTypes))
+
+		for name, typ := range providerType.AttributeTypes {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+
+		values[attr.ProxyURL] = tftypes.NewValue(tftypes.String, "proxy.corp:3128")
+		values[attr.NoProxy] = tftypes.NewValue(tftypes.String, "localhost")
+
+		configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+			Config: dynamicValue(t, providerType, tftypes.NewValue(providerType, values)),
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, configureResp.Diagnostics)
+
+		for _, diagnostic := range configureResp.Diagnostics {
+			assert.Equal(t, "Unable to create Soc2bd client", diagnostic.Summary)
+			assert.Contains(t, diagnostic.Detail, client.ErrInvalidProxyConfig.Error())
+		}
+	})
+}
+
+func TestProviderSharesClient(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Provider Shares Client With Framework Provider", func(t *testing.T) {
+		srv := fake.NewServer()
+		t.Cleanup(srv.Close)
+
+		t.Setenv(soc2bd.EnvURL, srv.URL)
+		t.Setenv(soc2bd.EnvNetwork, fake.Network)
+		t.Setenv(soc2bd.EnvAPIToken, fake.APIToken)
+
+		ctx := context.Background()
+		server := newProviderServer(t)
+
+		schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+		require.NoError(t, err)
+
+		providerType := schemaResp.Provider.ValueType()
+		configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+			Config: dynamicValue(t, providerType, tftypes.NewValue(providerType, nil)),
+		})
+		require.NoError(t, err)
+		require.Empty(t, configureResp.Diagnostics)
+
+		frameworkProvider := soc2bd.NewFrameworkProvider("test")
+
+		var frameworkSchema provider.SchemaResponse
+		frameworkProvider.Schema(ctx, provider.SchemaRequest{}, &frameworkSchema)
+
+		frameworkType := frameworkSchema.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		values := make(map[string]tftypes.Value, len(frameworkType.AttributeTypes))
+
+		for name, typ := range frameworkType.AttributeTypes {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+
+		var frameworkConfigure provider.ConfigureResponse
+		frameworkProvider.Configure(ctx, provider.ConfigureRequest{
+			Config: tfsdk.Config{Schema: frameworkSchema.Schema, Raw: tftypes.NewValue(frameworkType, values)},
+		}, &frameworkConfigure)
+		require.False(t, frameworkConfigure.Diagnostics.HasError())
+
+		// both providers use the same client, with a single rate limiter and token source
+		assert.Same(t, Provider.Meta(), frameworkConfigure.ResourceData)
+		assert.Same(t, Provider.Meta(), frameworkConfigure.DataSourceData)
+	})
+}
@@ -6,10 +6,11 @@ import (
 	"testing"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider/resource"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test/acctests"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -21,9 +22,9 @@ func TestAccRemoteConnectorWithTokens(t *testing.T) {
 		remoteNetworkName := test.RandomName()
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      checkSoc2bdConnectorTokensInvalidated,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             checkSoc2bdConnectorTokensInvalidated,
 			Steps: []sdk.TestStep{
 				{
 					Config: terraformResourceSoc2bdConnectorTokens(terraformResourceName, remoteNetworkName),
@@ -36,6 +37,64 @@ func TestAccRemoteConnectorWithTokens(t *testing.T) {
 	})
 }
 
+func TestAccRemoteConnectorTokensWithRotation(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Acc Remote Connector Tokens With Rotation", func(t *testing.T) {
+		const terraformResourceName = "test_t2"
+		theResource := acctests.TerraformConnectorTokens(terraformResourceName)
+		remoteNetworkName := test.RandomName()
+
+		sdk.Test(t, sdk.TestCase{
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             checkSoc2bdConnectorTokensInvalidated,
+			Steps: []sdk.TestStep{
+				{
+					Config: terraformResourceSoc2bdConnectorTokensWithRotation(terraformResourceName, remoteNetworkName, 30),
+					Check: acctests.ComposeTestCheckFunc(
+						checkSoc2bdConnectorTokensSet(theResource),
+						sdk.TestCheckResourceAttr(theResource, attr.Status, model.ConnectorTokensStatusValid),
+						sdk.TestCheckResourceAttrSet(theResource, attr.IssuedAt),
+						sdk.TestCheckResourceAttrSet(theResource, attr.ExpiresAt),
+					),
+				},
+				{
+					Config: terraformResourceSoc2bdConnectorTokensWithRotation(terraformResourceName, remoteNetworkName, 60),
+					Check: acctests.ComposeTestCheckFunc(
+						sdk.TestCheckResourceAttr(theResource, attr.RotationDays, "60"),
+						sdk.TestCheckResourceAttr(theResource, attr.Status, model.ConnectorTokensStatusValid),
+					),
+				},
+				{
+					Config: terraformResourceSoc2bdConnectorTokensWithRotation(terraformResourceName, remoteNetworkName, 60),
+					Check: acctests.ComposeTestCheckFunc(
+						acctests.InvalidateSoc2bdConnectorTokens(theResource),
+					),
+					// invalidated tokens are replaced on the next apply
+					ExpectNonEmptyPlan: true,
+				},
+				{
+					Config: terraformResourceSoc2bdConnectorTokensWithRotation(terraformResourceName, remoteNetworkName, 60),
+					Check: acctests.ComposeTestCheckFunc(
+						sdk.TestCheckResourceAttr(theResource, attr.Status, model.ConnectorTokensStatusValid),
+					),
+				},
+			},
+		})
+	})
+}
+
+func terraformResourceSoc2bdConnectorTokensWithRotation(terraformResourceName, remoteNetworkName string, rotationDays int) string {
+	return fmt.Sprintf(`
+	%s
+
+	resource "soc2bd_connector_tokens" "%s" {
+	  connector_id  = soc2bd_connector.%s.id
+	  rotation_days = %d
+	  rotate_before = 7
+	}
+	`, terraformResourceSoc2bdConnector(terraformResourceName, terraformResourceName, remoteNetworkName), terraformResourceName, terraformResourceName, rotationDays)
+}
+
 func terraformResourceSoc2bdConnectorTokens(terraformResourceName, remoteNetworkName string) string {
 	return fmt.Sprintf(`
 	%s
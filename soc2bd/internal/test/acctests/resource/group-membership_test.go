@@ -0,0 +1,123 @@
+package resource
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test/acctests"
+	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSoc2bdGroupMembershipCreateDelete(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Acc Group Membership Create/Delete", func(t *testing.T) {
+		const terraformResourceName = "test_gm1"
+		theGroup := acctests.TerraformGroup(terraformResourceName)
+		theMembership := acctests.TerraformGroupMembership(terraformResourceName)
+		groupName := test.RandomName()
+
+		users, userIDs := genNewUsers("u_gm1", 2)
+
+		sdk.Test(t, sdk.TestCase{
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdGroupDestroy,
+			Steps: []sdk.TestStep{
+				{
+					Config: terraformResourceSoc2bdGroupMembership(terraformResourceName, groupName, users, userIDs[:1], userIDs[1]),
+					Check: acctests.ComposeTestCheckFunc(
+						acctests.CheckSoc2bdResourceExists(theMembership),
+						sdk.TestCheckResourceAttrPair(theMembership, attr.GroupID, theGroup, attr.ID),
+						sdk.TestCheckResourceAttrPair(theMembership, attr.UserID, acctests.TerraformUser("u_gm1_2"), attr.ID),
+						// the non-authoritative group keeps only its own user in state
+						sdk.TestCheckResourceAttr(theGroup, userIdsLen, "1"),
+						acctests.CheckGroupUsersLen(theGroup, 2),
+					),
+				},
+				{
+					// expecting no drift
+					Config:   terraformResourceSoc2bdGroupMembership(terraformResourceName, groupName, users, userIDs[:1], userIDs[1]),
+					PlanOnly: true,
+				},
+				{
+					ResourceName:      theMembership,
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				{
+					Config: terraformResourceSoc2bdGroupMembership(terraformResourceName, groupName, users, userIDs[:1], userIDs[1]),
+					Check: acctests.ComposeTestCheckFunc(
+						// remove the member though API
+						acctests.DeleteGroupUser(theGroup, userIDs[1]),
+						acctests.WaitTestFunc(),
+						acctests.CheckGroupUsersLen(theGroup, 1),
+					),
+					// expecting drift - terraform going to add the user again
+					ExpectNonEmptyPlan: true,
+				},
+				{
+					Config: terraformResourceSoc2bdGroupMembership(terraformResourceName, groupName, users, userIDs[:1], userIDs[1]),
+					Check: acctests.ComposeTestCheckFunc(
+						acctests.CheckGroupUsersLen(theGroup, 2),
+					),
+				},
+				{
+					// removing the membership leaves the other group users
+					Config: terraformResourceSoc2bdGroupWithUsersAuthoritative(terraformResourceName, groupName, users, userIDs[:1], false),
+					Check: acctests.ComposeTestCheckFunc(
+						acctests.CheckSoc2bdResourceDoesNotExists(theMembership),
+						sdk.TestCheckResourceAttr(theGroup, userIdsLen, "1"),
+						acctests.CheckGroupUsersLen(theGroup, 1),
+					),
+				},
+			},
+		})
+	})
+}
+
+func terraformResourceSoc2bdGroupMembership(terraformResourceName, name string, users, groupUserIDs []string, memberID string) string {
+	return fmt.Sprintf(`
+	%s
+
+	resource "soc2bd_group" "%s" {
+	  name = "%s"
+	  user_ids = [%s]
+	  is_authoritative = false
+	}
+
+	resource "soc2bd_group_membership" "%s" {
+	  group_id = soc2bd_group.%s.id
+	  user_id = %s
+	}
+	`, strings.Join(users, "\n"), terraformResourceName, name, strings.Join(groupUserIDs, ", "),
+		terraformResourceName, terraformResourceName, memberID)
+}
+
+func TestAccSoc2bdGroupMembershipInvalidImportID(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Acc Group Membership Invalid Import ID", func(t *testing.T) {
+		const terraformResourceName = "test_gm2"
+		groupName := test.RandomName()
+
+		users, userIDs := genNewUsers("u_gm2", 1)
+
+		sdk.Test(t, sdk.TestCase{
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdGroupDestroy,
+			Steps: []sdk.TestStep{
+				{
+					Config: terraformResourceSoc2bdGroupMembership(terraformResourceName, groupName, users, nil, userIDs[0]),
+				},
+				{
+					ResourceName:  acctests.TerraformGroupMembership(terraformResourceName),
+					ImportState:   true,
+					ImportStateId: "group-id",
+					ExpectError:   regexp.MustCompile("invalid group membership id"),
+				},
+			},
+		})
+	})
+}
@@ -2,6 +2,7 @@ package resource
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -24,9 +25,9 @@ func TestAccSoc2bdGroupCreateUpdate(t *testing.T) {
 		nameAfter := test.RandomName()
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdGroupDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdGroupDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: terraformResourceSoc2bdGroup(terraformResourceName, nameBefore),
@@ -55,6 +56,49 @@ func terraformResourceSoc2bdGroup(terraformResourceName, name string) string {
 	`, terraformResourceName, name)
 }
 
+func TestAccSoc2bdGroupDeletionProtection(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Acc Group Deletion Protection", func(t *testing.T) {
+		const terraformResourceName = "test_deletion_protection"
+		theResource := acctests.TerraformGroup(terraformResourceName)
+		groupName := test.RandomName()
+
+		sdk.Test(t, sdk.TestCase{
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdGroupDestroy,
+			Steps: []sdk.TestStep{
+				{
+					Config: terraformResourceSoc2bdGroupWithDeletionProtection(terraformResourceName, groupName, true),
+					Check: acctests.ComposeTestCheckFunc(
+						acctests.CheckSoc2bdResourceExists(theResource),
+						sdk.TestCheckResourceAttr(theResource, attr.DeletionProtection, "true"),
+					),
+				},
+				{
+					Config:      terraformResourceSoc2bdGroupWithDeletionProtection(terraformResourceName, groupName, true),
+					Destroy:     true,
+					ExpectError: regexp.MustCompile("deletion protection is enabled"),
+				},
+				{
+					Config: terraformResourceSoc2bdGroupWithDeletionProtection(terraformResourceName, groupName, false),
+					Check: acctests.ComposeTestCheckFunc(
+						sdk.TestCheckResourceAttr(theResource, attr.DeletionProtection, "false"),
+					),
+				},
+			},
+		})
+	})
+}
+
+func terraformResourceSoc2bdGroupWithDeletionProtection(terraformResourceName, name string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+	resource "soc2bd_group" "%s" {
+	  name = "%s"
+	  deletion_protection = %v
+	}
+	`, terraformResourceName, name, deletionProtection)
+}
+
 func TestAccSoc2bdGroupDeleteNonExisting(t *testing.T) {
 	t.Run("Test Soc2bd Resource : Acc Group Delete NonExisting", func(t *testing.T) {
 		const terraformResourceName = "test002"
@@ -62,9 +106,9 @@ func TestAccSoc2bdGroupDeleteNonExisting(t *testing.T) {
 		groupName := test.RandomName()
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdGroupDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdGroupDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config:  terraformResourceSoc2bdGroup(terraformResourceName, groupName),
@@ -85,9 +129,9 @@ func TestAccSoc2bdGroupReCreateAfterDeletion(t *testing.T) {
 		groupName := test.RandomName()
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdGroupDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdGroupDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: terraformResourceSoc2bdGroup(terraformResourceName, groupName),
@@ -122,9 +166,9 @@ func TestAccSoc2bdGroupWithSecurityPolicy(t *testing.T) {
 		testPolicy := securityPolicies[0]
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdGroupDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdGroupDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: terraformResourceSoc2bdGroup(terraformResourceName, name),
@@ -173,9 +217,9 @@ func TestAccSoc2bdGroupUsersAuthoritativeByDefault(t *testing.T) {
 		users, userIDs := genNewUsers("u005", 3)
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdGroupDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdGroupDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: terraformResourceSoc2bdGroupWithUsers(terraformResourceName, groupName, users, userIDs[:1]),
@@ -286,9 +330,9 @@ func TestAccSoc2bdGroupUsersNotAuthoritative(t *testing.T) {
 		users, userIDs := genNewUsers("u006", 3)
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdGroupDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdGroupDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: terraformResourceSoc2bdGroupWithUsersAuthoritative(terraformResourceName, groupName, users, userIDs[:1], false),
@@ -347,9 +391,9 @@ func TestAccSoc2bdGroupUsersCursor(t *testing.T) {
 		users, userIDs := genNewUsers("u007", 3)
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdGroupDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdGroupDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: terraformResourceSoc2bdGroupAndUsers(terraformResourceName, groupName, users, userIDs),
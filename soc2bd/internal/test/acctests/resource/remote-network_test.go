@@ -5,10 +5,10 @@ import (
 	"testing"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider/resource"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test/acctests"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -20,9 +20,9 @@ func TestAccSoc2bdRemoteNetworkCreate(t *testing.T) {
 		networkLocation := model.LocationAzure
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdRemoteNetworkDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdRemoteNetworkDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: createRemoteNetworkWithLocation(terraformResourceName, networkName, networkLocation),
@@ -30,6 +30,7 @@ func TestAccSoc2bdRemoteNetworkCreate(t *testing.T) {
 						acctests.CheckSoc2bdResourceExists(theResource),
 						sdk.TestCheckResourceAttr(theResource, attr.Name, networkName),
 						sdk.TestCheckResourceAttr(theResource, attr.Location, networkLocation),
+						sdk.TestCheckResourceAttr(theResource, attr.HealthyConnectors, "0"),
 					),
 				},
 			},
@@ -54,9 +55,9 @@ func TestAccSoc2bdRemoteNetworkUpdate(t *testing.T) {
 		nameAfter := test.RandomName()
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdRemoteNetworkDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdRemoteNetworkDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: terraformResourceRemoteNetwork(terraformResourceName, nameBefore),
@@ -94,9 +95,9 @@ func TestAccSoc2bdRemoteNetworkDeleteNonExisting(t *testing.T) {
 		remoteNetworkNameBefore := test.RandomName()
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdRemoteNetworkDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdRemoteNetworkDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config:  terraformResourceRemoteNetwork(terraformResourceName, remoteNetworkNameBefore),
@@ -117,9 +118,9 @@ func TestAccSoc2bdRemoteNetworkReCreateAfterDeletion(t *testing.T) {
 		remoteNetworkName := test.RandomName()
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdRemoteNetworkDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdRemoteNetworkDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: terraformResourceRemoteNetwork(terraformResourceName, remoteNetworkName),
@@ -147,9 +148,9 @@ func TestAccSoc2bdRemoteNetworkUpdateWithTheSameName(t *testing.T) {
 		name := test.RandomName()
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdRemoteNetworkDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdRemoteNetworkDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: terraformResourceRemoteNetwork(terraformResourceName, name),
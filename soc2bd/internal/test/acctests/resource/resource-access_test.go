@@ -0,0 +1,134 @@
+package resource
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test/acctests"
+	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSoc2bdResourceAccessCreateDelete(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Acc Resource Access Create/Delete", func(t *testing.T) {
+		const terraformResourceName = "test_ra1"
+		theResource := acctests.TerraformResource(terraformResourceName)
+		groupAccess := acctests.TerraformResourceAccess(terraformResourceName + "_group")
+		serviceAccountAccess := acctests.TerraformResourceAccess(terraformResourceName + "_service_account")
+		remoteNetworkName := test.RandomName()
+		resourceName := test.RandomResourceName()
+		serviceAccountName := test.RandomName()
+		groups, groupsID := genNewGroups("g_ra1", 2)
+
+		sdk.Test(t, sdk.TestCase{
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
+			Steps: []sdk.TestStep{
+				{
+					Config: terraformResourceSoc2bdResourceAccess(terraformResourceName, remoteNetworkName, resourceName, serviceAccountName, groups, groupsID, true),
+					Check: acctests.ComposeTestCheckFunc(
+						acctests.CheckSoc2bdResourceExists(groupAccess),
+						sdk.TestCheckResourceAttrPair(groupAccess, attr.ResourceID, theResource, attr.ID),
+						sdk.TestCheckResourceAttrPair(groupAccess, attr.GroupID, getResourceNameFromID(groupsID[1]), attr.ID),
+						sdk.TestCheckResourceAttr(groupAccess, attr.ServiceAccountID, ""),
+						acctests.CheckSoc2bdResourceExists(serviceAccountAccess),
+						sdk.TestCheckResourceAttrPair(serviceAccountAccess, attr.ServiceAccountID, acctests.TerraformServiceAccount(terraformResourceName), attr.ID),
+						// the non-authoritative resource keeps only its own group in state
+						sdk.TestCheckResourceAttr(theResource, accessGroupIdsLen, "1"),
+						acctests.CheckResourceGroupsLen(theResource, 2),
+						acctests.CheckResourceServiceAccountsLen(theResource, 1),
+					),
+				},
+				{
+					// expecting no drift
+					Config:   terraformResourceSoc2bdResourceAccess(terraformResourceName, remoteNetworkName, resourceName, serviceAccountName, groups, groupsID, true),
+					PlanOnly: true,
+				},
+				{
+					ResourceName:      groupAccess,
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				{
+					ResourceName:      serviceAccountAccess,
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				{
+					Config: terraformResourceSoc2bdResourceAccess(terraformResourceName, remoteNetworkName, resourceName, serviceAccountName, groups, groupsID, true),
+					Check: acctests.ComposeTestCheckFunc(
+						// revoke the group access though API
+						acctests.DeleteResourceGroup(theResource, getResourceNameFromID(groupsID[1])),
+						acctests.WaitTestFunc(),
+						acctests.CheckResourceGroupsLen(theResource, 1),
+					),
+					// expecting drift - terraform going to grant the access again
+					ExpectNonEmptyPlan: true,
+				},
+				{
+					Config: terraformResourceSoc2bdResourceAccess(terraformResourceName, remoteNetworkName, resourceName, serviceAccountName, groups, groupsID, true),
+					Check: acctests.ComposeTestCheckFunc(
+						acctests.CheckResourceGroupsLen(theResource, 2),
+					),
+				},
+				{
+					// removing the grants leaves the resource access
+					Config: terraformResourceSoc2bdResourceAccess(terraformResourceName, remoteNetworkName, resourceName, serviceAccountName, groups, groupsID, false),
+					Check: acctests.ComposeTestCheckFunc(
+						acctests.CheckSoc2bdResourceDoesNotExists(groupAccess),
+						acctests.CheckSoc2bdResourceDoesNotExists(serviceAccountAccess),
+						sdk.TestCheckResourceAttr(theResource, accessGroupIdsLen, "1"),
+						acctests.CheckResourceGroupsLen(theResource, 1),
+						acctests.CheckResourceServiceAccountsLen(theResource, 0),
+					),
+				},
+			},
+		})
+	})
+}
+
+func terraformResourceSoc2bdResourceAccess(terraformResourceName, networkName, resourceName, serviceAccountName string, groups, groupsID []string, withGrants bool) string {
+	var grants string
+
+	if withGrants {
+		grants = fmt.Sprintf(`
+	resource "soc2bd_resource_access" "%[1]s_group" {
+	  resource_id = soc2bd_resource.%[1]s.id
+	  group_id = %[2]s
+	}
+
+	resource "soc2bd_resource_access" "%[1]s_service_account" {
+	  resource_id = soc2bd_resource.%[1]s.id
+	  service_account_id = soc2bd_service_account.%[1]s.id
+	}
+	`, terraformResourceName, groupsID[1])
+	}
+
+	return fmt.Sprintf(`
+	resource "soc2bd_remote_network" "%[1]s" {
+	  name = "%[2]s"
+	}
+
+	%[3]s
+
+	resource "soc2bd_service_account" "%[1]s" {
+	  name = "%[4]s"
+	}
+
+	resource "soc2bd_resource" "%[1]s" {
+	  name = "%[5]s"
+	  address = "acc-test.com.ra1"
+	  remote_network_id = soc2bd_remote_network.%[1]s.id
+
+	  is_authoritative = false
+	  access {
+	    group_ids = [%[6]s]
+	  }
+	}
+
+	%[7]s
+	`, terraformResourceName, networkName, strings.Join(groups, "\n"), serviceAccountName, resourceName, groupsID[0], grants)
+}
@@ -7,10 +7,10 @@ import (
 	"testing"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider/resource"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test/acctests"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stretchr/testify/assert"
 )
@@ -33,9 +33,9 @@ func TestAccSoc2bdResourceCreate(t *testing.T) {
 	resourceName := test.RandomResourceName()
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createResourceOnlyWithNetwork(terraformResourceName, remoteNetworkName, resourceName),
@@ -72,9 +72,9 @@ func TestAccSoc2bdResourceCreateWithProtocolsAndGroups(t *testing.T) {
 	resourceName := test.RandomResourceName()
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createResourceWithProtocolsAndGroups(remoteNetworkName, groupName1, groupName2, resourceName),
@@ -134,9 +134,9 @@ func TestAccSoc2bdResourceFullCreationFlow(t *testing.T) {
 	resourceName := test.RandomResourceName()
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: resourceFullCreationFlow(remoteNetworkName, groupName, resourceName),
@@ -204,8 +204,8 @@ func TestAccSoc2bdResourceWithInvalidGroupId(t *testing.T) {
 	networkName := test.RandomResourceName()
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
 		Steps: []sdk.TestStep{
 			{
 				Config:      createResourceWithInvalidGroupId(networkName, resourceName),
@@ -239,9 +239,9 @@ func TestAccSoc2bdResourceWithTcpDenyAllPolicy(t *testing.T) {
 	groupName := test.RandomResourceName()
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createResourceWithTcpDenyAllPolicy(networkName, groupName, resourceName),
@@ -296,9 +296,9 @@ func TestAccSoc2bdResourceWithUdpDenyAllPolicy(t *testing.T) {
 	resourceName := test.RandomResourceName()
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createResourceWithUdpDenyAllPolicy(remoteNetworkName, groupName, resourceName),
@@ -347,25 +347,17 @@ func createResourceWithUdpDenyAllPolicy(networkName, groupName, resourceName str
 }
 
 func TestAccSoc2bdResourceWithRestrictedPolicyAndEmptyPortsList(t *testing.T) {
-	const theResource = "soc2bd_resource.test7"
 	remoteNetworkName := test.RandomName()
 	groupName := test.RandomGroupName()
 	resourceName := test.RandomResourceName()
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
 		Steps: []sdk.TestStep{
 			{
-				Config: createResourceWithRestrictedPolicyAndEmptyPortsList(remoteNetworkName, groupName, resourceName),
-				Check: acctests.ComposeTestCheckFunc(
-					sdk.TestCheckResourceAttr(theResource, attr.Name, resourceName),
-					sdk.TestCheckResourceAttr(theResource, tcpPolicy, model.PolicyRestricted),
-					sdk.TestCheckNoResourceAttr(theResource, tcpPortsLen),
-					sdk.TestCheckResourceAttr(theResource, udpPolicy, model.PolicyRestricted),
-					sdk.TestCheckNoResourceAttr(theResource, udpPortsLen),
-				),
+				Config:      createResourceWithRestrictedPolicyAndEmptyPortsList(remoteNetworkName, groupName, resourceName),
+				ExpectError: regexp.MustCompile("policy RESTRICTED allows no port"),
 			},
 		},
 	})
@@ -412,8 +404,8 @@ func TestAccSoc2bdResourceWithInvalidPortRange(t *testing.T) {
 	}
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
 		Steps: []sdk.TestStep{
 			{
 				Config:      genConfig(`""`),
@@ -481,9 +473,9 @@ func TestAccSoc2bdResourcePortReorderingCreatesNoChanges(t *testing.T) {
 	resourceName := test.RandomResourceName()
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createResourceWithPortRange(remoteNetworkName, resourceName, `"82-83", "80"`),
@@ -516,6 +508,37 @@ func TestAccSoc2bdResourcePortReorderingCreatesNoChanges(t *testing.T) {
 	})
 }
 
+func TestAccSoc2bdResourceWithOverlappingPorts(t *testing.T) {
+	const theResource = "soc2bd_resource.test9"
+	remoteNetworkName := test.RandomName()
+	resourceName := test.RandomResourceName()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: createResourceWithPortRange(remoteNetworkName, resourceName, `"80", "80-90", "91"`),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckSoc2bdResourceExists(theResource),
+					sdk.TestCheckResourceAttr(theResource, tcpPortsLen, "3"),
+				),
+			},
+			// no changes, the merged ports allow the same ports
+			{
+				Config:   createResourceWithPortRange(remoteNetworkName, resourceName, `"80-91"`),
+				PlanOnly: true,
+			},
+			// no changes, duplicates don't cause diffs
+			{
+				Config:   createResourceWithPortRange(remoteNetworkName, resourceName, `"80-91", "80", "85-86"`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func createResourceWithPortRange(networkName, resourceName, portRange string) string {
 	return fmt.Sprintf(`
 	resource "soc2bd_remote_network" "test9" {
@@ -548,9 +571,9 @@ func TestAccSoc2bdResourceSetActiveStateOnUpdate(t *testing.T) {
 	resourceName := test.RandomResourceName()
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createResourceOnlyWithNetwork(terraformResourceName, remoteNetworkName, resourceName),
@@ -560,17 +583,66 @@ func TestAccSoc2bdResourceSetActiveStateOnUpdate(t *testing.T) {
 					acctests.WaitTestFunc(),
 					acctests.CheckSoc2bdResourceActiveState(theResource, false),
 				),
+				// the deactivation is shown as drift, not fixed on refresh
+				ExpectNonEmptyPlan: true,
 			},
 			{
 				Config: createResourceOnlyWithNetwork(terraformResourceName, remoteNetworkName, resourceName),
 				Check: acctests.ComposeTestCheckFunc(
 					acctests.CheckSoc2bdResourceActiveState(theResource, true),
+					sdk.TestCheckResourceAttr(theResource, attr.IsActive, "true"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccSoc2bdResourceIsActive(t *testing.T) {
+	const terraformResourceName = "test_is_active"
+	theResource := acctests.TerraformResource(terraformResourceName)
+	remoteNetworkName := test.RandomName()
+	resourceName := test.RandomResourceName()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDeactivated,
+		Steps: []sdk.TestStep{
+			{
+				Config: createResourceWithActiveState(terraformResourceName, remoteNetworkName, resourceName, false),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckSoc2bdResourceExists(theResource),
+					acctests.CheckSoc2bdResourceActiveState(theResource, false),
+					sdk.TestCheckResourceAttr(theResource, attr.IsActive, "false"),
+					sdk.TestCheckResourceAttr(theResource, attr.DeactivateOnDestroy, "true"),
+				),
+			},
+			{
+				Config: createResourceWithActiveState(terraformResourceName, remoteNetworkName, resourceName, true),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckSoc2bdResourceActiveState(theResource, true),
+					sdk.TestCheckResourceAttr(theResource, attr.IsActive, "true"),
+				),
+			},
+		},
+	})
+}
+
+func createResourceWithActiveState(terraformResourceName, networkName, resourceName string, isActive bool) string {
+	return fmt.Sprintf(`
+	resource "soc2bd_remote_network" "%s" {
+	  name = "%s"
+	}
+	resource "soc2bd_resource" "%s" {
+	  name = "%s"
+	  address = "acc-test.com"
+	  remote_network_id = soc2bd_remote_network.%s.id
+	  is_active = %v
+	  deactivate_on_destroy = true
+	}
+	`, terraformResourceName, networkName, terraformResourceName, resourceName, terraformResourceName, isActive)
+}
+
 func TestAccSoc2bdResourceReCreateAfterDeletion(t *testing.T) {
 	const terraformResourceName = "test10"
 	theResource := acctests.TerraformResource(terraformResourceName)
@@ -578,9 +650,9 @@ func TestAccSoc2bdResourceReCreateAfterDeletion(t *testing.T) {
 	resourceName := test.RandomResourceName()
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createResourceOnlyWithNetwork(terraformResourceName, remoteNetworkName, resourceName),
@@ -608,9 +680,9 @@ func TestAccSoc2bdResourceImport(t *testing.T) {
 	resourceName := test.RandomResourceName()
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createResource12(remoteNetworkName, groupName, groupName2, resourceName),
@@ -719,9 +791,9 @@ func TestAccSoc2bdResourceAddAccessServiceAccounts(t *testing.T) {
 	serviceAccountName := test.RandomName("s15")
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createResource15(remoteNetworkName, resourceName, createServiceAccount(resourceName, serviceAccountName)),
@@ -774,9 +846,9 @@ func TestAccSoc2bdResourceAddAccessGroupsAndServiceAccounts(t *testing.T) {
 	groups, groupsID := genNewGroups("g16", 1)
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createResource16(remoteNetworkName, resourceName, groups, groupsID, createServiceAccount(resourceName, serviceAccountName)),
@@ -835,9 +907,9 @@ func TestAccSoc2bdResourceAccessServiceAccountsNotAuthoritative(t *testing.T) {
 	serviceAccountResource := getResourceNameFromID(serviceAccountIDs[2])
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createResource17(remoteNetworkName, resourceName, serviceAccounts, serviceAccountIDs[:1]),
@@ -945,9 +1017,9 @@ func TestAccSoc2bdResourceAccessServiceAccountsAuthoritative(t *testing.T) {
 	serviceAccountResource := getResourceNameFromID(serviceAccountIDs[2])
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createResource13(remoteNetworkName, resourceName, serviceAccounts, serviceAccountIDs[:1]),
@@ -1047,9 +1119,9 @@ func TestAccSoc2bdResourceAccessWithEmptyGroups(t *testing.T) {
 	resourceName := test.RandomResourceName()
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config:      createResource18(remoteNetworkName, resourceName),
@@ -1094,9 +1166,9 @@ func TestAccSoc2bdResourceAccessWithEmptyServiceAccounts(t *testing.T) {
 	resourceName := test.RandomResourceName()
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config:      createResource19(remoteNetworkName, resourceName),
@@ -1141,9 +1213,9 @@ func TestAccSoc2bdResourceAccessWithEmptyBlock(t *testing.T) {
 	resourceName := test.RandomResourceName()
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config:      createResource20(remoteNetworkName, resourceName),
@@ -1192,9 +1264,9 @@ func TestAccSoc2bdResourceAccessGroupsNotAuthoritative(t *testing.T) {
 	groupResource := getResourceNameFromID(groupsID[2])
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createResource22(remoteNetworkName, resourceName, groups, groupsID[:1]),
@@ -1302,9 +1374,9 @@ func TestAccSoc2bdResourceAccessGroupsAuthoritative(t *testing.T) {
 	groupResource := getResourceNameFromID(groupsID[2])
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createResource23(remoteNetworkName, resourceName, groups, groupsID[:1]),
@@ -1431,9 +1503,9 @@ func TestAccSoc2bdCreateResourceWithFlagIsVisible(t *testing.T) {
 	resourceName := test.RandomResourceName()
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createSimpleResource(terraformResourceName, remoteNetworkName, resourceName),
@@ -1510,9 +1582,9 @@ func TestAccSoc2bdCreateResourceWithFlagIsBrowserShortcutEnabled(t *testing.T) {
 	resourceName := test.RandomResourceName()
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createSimpleResource(terraformResourceName, remoteNetworkName, resourceName),
@@ -1579,9 +1651,9 @@ func TestAccSoc2bdResourceGroupsAuthoritativeByDefault(t *testing.T) {
 	groupResource := getResourceNameFromID(groupsID[2])
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createResource26(remoteNetworkName, resourceName, groups, groupsID[:1]),
@@ -1682,9 +1754,9 @@ func TestAccSoc2bdResourceDoesNotSupportOldGroups(t *testing.T) {
 	groups, groupsID := genNewGroups("g28", 2)
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config:      createResource28(remoteNetworkName, resourceName, groups, groupsID),
@@ -1732,9 +1804,9 @@ func TestAccSoc2bdResourceCreateWithAlias(t *testing.T) {
 	aliasName := test.RandomName()
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createResource29(terraformResourceName, remoteNetworkName, resourceName, aliasName),
@@ -1790,6 +1862,78 @@ func createResource29WithoutAlias(terraformResourceName, networkName, resourceNa
 	`, terraformResourceName, networkName, terraformResourceName, resourceName, terraformResourceName)
 }
 
+func TestAccSoc2bdResourceAddressType(t *testing.T) {
+	const terraformResourceName = "test_address_type"
+	theResource := acctests.TerraformResource(terraformResourceName)
+	remoteNetworkName := test.RandomName()
+	resourceName := test.RandomResourceName()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: createResourceWithAddress(terraformResourceName, remoteNetworkName, resourceName, "acc-test.com"),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckSoc2bdResourceExists(theResource),
+					sdk.TestCheckResourceAttr(theResource, attr.AddressType, model.AddressTypeFQDN),
+				),
+			},
+			{
+				Config: createResourceWithAddress(terraformResourceName, remoteNetworkName, resourceName, "10.0.0.0/24"),
+				Check: acctests.ComposeTestCheckFunc(
+					sdk.TestCheckResourceAttr(theResource, attr.AddressType, model.AddressTypeCIDR),
+				),
+			},
+			{
+				Config: createResourceWithAddress(terraformResourceName, remoteNetworkName, resourceName, "*.acc-test.com"),
+				Check: acctests.ComposeTestCheckFunc(
+					sdk.TestCheckResourceAttr(theResource, attr.AddressType, model.AddressTypeWildcard),
+				),
+			},
+		},
+	})
+}
+
+func createResourceWithAddress(terraformResourceName, networkName, resourceName, address string) string {
+	return fmt.Sprintf(`
+	resource "soc2bd_remote_network" "%s" {
+	  name = "%s"
+	}
+	resource "soc2bd_resource" "%s" {
+	  name = "%s"
+	  address = "%s"
+	  remote_network_id = soc2bd_remote_network.%s.id
+	}
+	`, terraformResourceName, networkName, terraformResourceName, resourceName, address, terraformResourceName)
+}
+
+func TestAccSoc2bdResourceWithInvalidAddressOrAlias(t *testing.T) {
+	const terraformResourceName = "test_invalid_address"
+	remoteNetworkName := test.RandomName()
+	resourceName := test.RandomResourceName()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		Steps: []sdk.TestStep{
+			{
+				Config:      createResourceWithAddress(terraformResourceName, remoteNetworkName, resourceName, "10.0.0.1/24"),
+				ExpectError: regexp.MustCompile("Invalid Resource Address"),
+			},
+			{
+				Config:      createResourceWithAddress(terraformResourceName, remoteNetworkName, resourceName, "*.acc-test..com"),
+				ExpectError: regexp.MustCompile("Invalid Resource Address"),
+			},
+			{
+				Config:      createResource29(terraformResourceName, remoteNetworkName, resourceName, "-alias.internal"),
+				ExpectError: regexp.MustCompile("Invalid Resource Alias"),
+			},
+		},
+	})
+}
+
 func TestAccSoc2bdResourceGroupsCursor(t *testing.T) {
 	acctests.SetPageLimit(1)
 
@@ -1801,9 +1945,9 @@ func TestAccSoc2bdResourceGroupsCursor(t *testing.T) {
 	serviceAccounts, serviceAccountIDs := genNewServiceAccounts("s27", 3)
 
 	sdk.Test(t, sdk.TestCase{
-		ProviderFactories: acctests.ProviderFactories,
-		PreCheck:          func() { acctests.PreCheck(t) },
-		CheckDestroy:      acctests.CheckSoc2bdResourceDestroy,
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckSoc2bdResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
 				Config: createResourceWithGroupsAndServiceAccounts(terraformResourceName, remoteNetworkName, resourceName, groups, groupsID, serviceAccounts, serviceAccountIDs),
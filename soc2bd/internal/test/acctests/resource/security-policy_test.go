@@ -0,0 +1,126 @@
+package resource
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test/acctests"
+	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var (
+	mfaMethodsLen              = attr.Len(attr.MFAMethods)
+	devicePostureLen           = attr.Len(attr.DevicePosture)
+	securityPolicyGroupIDsLen  = attr.Len(attr.GroupIDs)
+	allowedOperatingSystemsLen = attr.Path(attr.DevicePosture, attr.Len(attr.AllowedOperatingSystems))
+)
+
+func TestAccSoc2bdSecurityPolicyCreateUpdate(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Acc Security Policy Create/Update", func(t *testing.T) {
+		const terraformResourceName = "test_sp1"
+		theResource := acctests.TerraformSecurityPolicy(terraformResourceName)
+		policyName := test.RandomName()
+
+		groups, groupIDs := genNewGroups("g_sp1", 2)
+
+		sdk.Test(t, sdk.TestCase{
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdSecurityPolicyDestroy,
+			Steps: []sdk.TestStep{
+				{
+					Config: terraformResourceSoc2bdSecurityPolicy(terraformResourceName, policyName),
+					Check: acctests.ComposeTestCheckFunc(
+						acctests.CheckSoc2bdResourceExists(theResource),
+						sdk.TestCheckResourceAttr(theResource, attr.Name, policyName),
+						sdk.TestCheckResourceAttr(theResource, attr.RequireMFA, "false"),
+						sdk.TestCheckResourceAttr(theResource, attr.SessionLifetimeHours, "0"),
+						sdk.TestCheckResourceAttr(theResource, devicePostureLen, "0"),
+						sdk.TestCheckNoResourceAttr(theResource, securityPolicyGroupIDsLen),
+					),
+				},
+				{
+					Config: terraformResourceSoc2bdSecurityPolicyWithRules(terraformResourceName, policyName, groups, groupIDs),
+					Check: acctests.ComposeTestCheckFunc(
+						sdk.TestCheckResourceAttr(theResource, attr.RequireMFA, "true"),
+						sdk.TestCheckResourceAttr(theResource, mfaMethodsLen, "2"),
+						sdk.TestCheckResourceAttr(theResource, attr.SessionLifetimeHours, "12"),
+						sdk.TestCheckResourceAttr(theResource, devicePostureLen, "1"),
+						sdk.TestCheckResourceAttr(theResource, attr.Path(attr.DevicePosture, attr.RequireDiskEncryption), "true"),
+						sdk.TestCheckResourceAttr(theResource, allowedOperatingSystemsLen, "2"),
+						sdk.TestCheckResourceAttr(theResource, securityPolicyGroupIDsLen, "2"),
+					),
+				},
+				{
+					// expecting no drift
+					Config:   terraformResourceSoc2bdSecurityPolicyWithRules(terraformResourceName, policyName, groups, groupIDs),
+					PlanOnly: true,
+				},
+				{
+					Config: terraformResourceSoc2bdSecurityPolicyWithRules(terraformResourceName, policyName, groups, groupIDs[:1]),
+					Check: acctests.ComposeTestCheckFunc(
+						sdk.TestCheckResourceAttr(theResource, securityPolicyGroupIDsLen, "1"),
+					),
+				},
+				{
+					ResourceName:            theResource,
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{attr.GroupIDs},
+				},
+			},
+		})
+	})
+}
+
+func TestAccSoc2bdSecurityPolicyInvalidMFAMethod(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Acc Security Policy Invalid MFA Method", func(t *testing.T) {
+		sdk.Test(t, sdk.TestCase{
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			Steps: []sdk.TestStep{
+				{
+					Config: fmt.Sprintf(`
+					resource "soc2bd_security_policy" "test_sp2" {
+					  name = "%s"
+					  mfa_methods = ["SMS"]
+					}
+					`, test.RandomName()),
+					ExpectError: regexp.MustCompile(`expected mfa_methods\.\d+ to be one of`),
+				},
+			},
+		})
+	})
+}
+
+func terraformResourceSoc2bdSecurityPolicy(terraformResourceName, name string) string {
+	return fmt.Sprintf(`
+	resource "soc2bd_security_policy" "%s" {
+	  name = "%s"
+	}
+	`, terraformResourceName, name)
+}
+
+func terraformResourceSoc2bdSecurityPolicyWithRules(terraformResourceName, name string, groups, groupIDs []string) string {
+	return fmt.Sprintf(`
+	%s
+
+	resource "soc2bd_security_policy" "%s" {
+	  name = "%s"
+	  require_mfa = true
+	  mfa_methods = ["TOTP", "WEBAUTHN"]
+	  session_lifetime_hours = 12
+
+	  device_posture {
+	    require_disk_encryption = true
+	    allowed_operating_systems = ["MACOS", "WINDOWS"]
+	  }
+
+	  group_ids = [%s]
+	}
+	`, strings.Join(groups, "\n"), terraformResourceName, name, strings.Join(groupIDs, ", "))
+}
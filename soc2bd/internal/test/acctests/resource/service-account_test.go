@@ -27,9 +27,9 @@ func TestAccSoc2bdServiceAccountCreateUpdate(t *testing.T) {
 		nameAfter := test.RandomName()
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdServiceAccountDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdServiceAccountDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: createServiceAccount(terraformResourceName, nameBefore),
@@ -57,9 +57,9 @@ func TestAccSoc2bdServiceAccountDeleteNonExisting(t *testing.T) {
 		name := test.RandomName()
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdServiceAccountDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdServiceAccountDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config:  createServiceAccount(terraformResourceName, name),
@@ -80,9 +80,9 @@ func TestAccSoc2bdServiceAccountReCreateAfterDeletion(t *testing.T) {
 		name := test.RandomName()
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdServiceAccountDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdServiceAccountDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: createServiceAccount(terraformResourceName, name),
@@ -6,10 +6,10 @@ import (
 	"testing"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider/resource"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test/acctests"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -36,6 +36,17 @@ func createServiceKeyWithName(terraformResourceName, serviceAccountName, service
 	`, createServiceAccount(terraformResourceName, serviceAccountName), terraformResourceName, terraformResourceName, serviceKeyName)
 }
 
+func createServiceKeyWithExpiration(terraformResourceName, serviceAccountName string, expirationTime int) string {
+	return fmt.Sprintf(`
+	%s
+
+	resource "soc2bd_service_account_key" "%s" {
+	  service_account_id = soc2bd_service_account.%s.id
+	  expiration_time = %d
+	}
+	`, createServiceAccount(terraformResourceName, serviceAccountName), terraformResourceName, terraformResourceName, expirationTime)
+}
+
 func nonEmptyValue(value string) error {
 	if value != "" {
 		return nil
@@ -52,9 +63,9 @@ func TestAccSoc2bdServiceKeyCreateUpdate(t *testing.T) {
 		serviceKey := acctests.TerraformServiceKey(terraformResourceName)
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdServiceAccountDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdServiceAccountDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: createServiceKey(terraformResourceName, serviceAccountName),
@@ -89,9 +100,9 @@ func TestAccSoc2bdServiceKeyCreateUpdateWithName(t *testing.T) {
 		afterName := test.RandomName()
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdServiceAccountDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdServiceAccountDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: createServiceKeyWithName(terraformResourceName, serviceAccountName, beforeName),
@@ -126,9 +137,9 @@ func TestAccSoc2bdServiceKeyReCreateAfterInactive(t *testing.T) {
 		serviceKey := acctests.TerraformServiceKey(terraformResourceName)
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdServiceAccountDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdServiceAccountDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: createServiceKey(terraformResourceName, serviceAccountName),
@@ -139,6 +150,8 @@ func TestAccSoc2bdServiceKeyReCreateAfterInactive(t *testing.T) {
 						acctests.WaitTestFunc(),
 						acctests.CheckSoc2bdServiceKeyStatus(serviceKey, model.StatusRevoked),
 					),
+					// revoked key is replaced on the next apply
+					ExpectNonEmptyPlan: true,
 				},
 				{
 					Config: createServiceKey(terraformResourceName, serviceAccountName),
@@ -160,9 +173,9 @@ func TestAccSoc2bdServiceKeyDelete(t *testing.T) {
 		serviceKey := acctests.TerraformServiceKey(terraformResourceName)
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdServiceAccountDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdServiceAccountDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config:  createServiceKey(terraformResourceName, serviceAccountName),
@@ -183,9 +196,9 @@ func TestAccSoc2bdServiceKeyReCreateAfterDeletion(t *testing.T) {
 		serviceKey := acctests.TerraformServiceKey(terraformResourceName)
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdServiceAccountDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdServiceAccountDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: createServiceKey(terraformResourceName, serviceAccountName),
@@ -207,3 +220,36 @@ func TestAccSoc2bdServiceKeyReCreateAfterDeletion(t *testing.T) {
 		})
 	})
 }
+
+func TestAccSoc2bdServiceKeyWithExpiration(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Acc Service Key With Expiration", func(t *testing.T) {
+		serviceAccountName := test.RandomName()
+		terraformResourceName := test.TerraformRandName("test_key")
+		serviceKey := acctests.TerraformServiceKey(terraformResourceName)
+
+		sdk.Test(t, sdk.TestCase{
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdServiceAccountDestroy,
+			Steps: []sdk.TestStep{
+				{
+					Config: createServiceKey(terraformResourceName, serviceAccountName),
+					Check: acctests.ComposeTestCheckFunc(
+						acctests.CheckSoc2bdResourceExists(serviceKey),
+						sdk.TestCheckResourceAttr(serviceKey, attr.ExpiresAt, ""),
+						sdk.TestCheckResourceAttr(serviceKey, attr.Status, model.StatusActive),
+					),
+				},
+				{
+					Config: createServiceKeyWithExpiration(terraformResourceName, serviceAccountName, 30),
+					Check: acctests.ComposeTestCheckFunc(
+						acctests.CheckSoc2bdResourceExists(serviceKey),
+						sdk.TestCheckResourceAttr(serviceKey, attr.ExpirationTime, "30"),
+						sdk.TestCheckResourceAttrWith(serviceKey, attr.ExpiresAt, nonEmptyValue),
+						sdk.TestCheckResourceAttr(serviceKey, attr.Status, model.StatusActive),
+					),
+				},
+			},
+		})
+	})
+}
@@ -6,10 +6,10 @@ import (
 	"testing"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider/resource"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test/acctests"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -23,9 +23,9 @@ func TestAccSoc2bdUserCreateUpdate(t *testing.T) {
 		role := model.UserRoleSupport
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdUserDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdUserDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: terraformResourceSoc2bdUser(terraformResourceName, email),
@@ -115,9 +115,9 @@ func TestAccSoc2bdUserFullCreate(t *testing.T) {
 		role := test.RandomUserRole()
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdUserDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdUserDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: terraformResourceSoc2bdUserFull(terraformResourceName, email, firstName, lastName, role),
@@ -154,9 +154,9 @@ func TestAccSoc2bdUserReCreation(t *testing.T) {
 		email2 := test.RandomEmail()
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdUserDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdUserDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: terraformResourceSoc2bdUser(terraformResourceName, email1),
@@ -184,9 +184,9 @@ func TestAccSoc2bdUserUpdateState(t *testing.T) {
 		email := test.RandomEmail()
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdUserDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdUserDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: terraformResourceSoc2bdUser(terraformResourceName, email),
@@ -220,9 +220,9 @@ func TestAccSoc2bdUserDelete(t *testing.T) {
 		theResource := acctests.TerraformUser(terraformResourceName)
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdUserDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdUserDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config:  terraformResourceSoc2bdUser(terraformResourceName, test.RandomEmail()),
@@ -243,9 +243,9 @@ func TestAccSoc2bdUserReCreateAfterDeletion(t *testing.T) {
 		email := test.RandomEmail()
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdUserDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdUserDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config: terraformResourceSoc2bdUser(terraformResourceName, email),
@@ -271,9 +271,9 @@ func TestAccSoc2bdUserCreateWithUnknownRole(t *testing.T) {
 		const terraformResourceName = "test007"
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdUserDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdUserDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config:      terraformResourceSoc2bdUserWithRole(terraformResourceName, test.RandomEmail(), "UnknownRole"),
@@ -289,9 +289,9 @@ func TestAccSoc2bdUserCreateWithoutEmail(t *testing.T) {
 		const terraformResourceName = "test008"
 
 		sdk.Test(t, sdk.TestCase{
-			ProviderFactories: acctests.ProviderFactories,
-			PreCheck:          func() { acctests.PreCheck(t) },
-			CheckDestroy:      acctests.CheckSoc2bdUserDestroy,
+			ProtoV6ProviderFactories: acctests.ProviderFactories,
+			PreCheck:                 func() { acctests.PreCheck(t) },
+			CheckDestroy:             acctests.CheckSoc2bdUserDestroy,
 			Steps: []sdk.TestStep{
 				{
 					Config:      terraformResourceSoc2bdUserWithoutEmail(terraformResourceName),
@@ -6,14 +6,19 @@ import (
 	"sync"
 	"time"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
 	"github.com/jarcoal/httpmock"
 )
 
 func newHTTPMockClient() *client.Client {
 
-	c := client.NewClient("twindev.com", "xxxx", "test",
-		time.Duration(1)*time.Second, 2, "test")
+	c := client.NewClient(
+		client.WithURL("twindev.com"),
+		client.WithAPIToken("xxxx"),
+		client.WithNetwork("test"),
+		client.WithHTTPTimeout(time.Duration(1)*time.Second),
+		client.WithHTTPMaxRetry(2),
+	)
 	httpmock.ActivateNonDefault(c.HTTPClient)
 
 	return c
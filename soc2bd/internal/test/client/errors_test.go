@@ -1,9 +1,12 @@
 package client
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -12,3 +15,70 @@ func TestUnwrapError(t *testing.T) {
 
 	assert.Equal(t, errBadRequest, err.Unwrap())
 }
+
+func TestErrorKind(t *testing.T) {
+	cases := []struct {
+		err      error
+		expected client.ErrorKind
+	}{
+		{err: errBadRequest, expected: client.ErrorKindUnknown},
+		{err: client.NewAPIError(errBadRequest, "read", "resource"), expected: client.ErrorKindUnknown},
+		{err: client.NewAPIErrorWithID(client.ErrGraphqlResultIsEmpty, "read", "resource", "id"), expected: client.ErrorKindNotFound},
+		{err: client.NewAPIErrorWithID(client.ErrGraphqlResultIsEmpty, "read", "resource", "All"), expected: client.ErrorKindUnknown},
+		{err: client.NewAPIErrorWithID(client.ErrGraphqlResultIsEmpty, "update", "resource", "id"), expected: client.ErrorKindUnknown},
+		{err: client.NewAPIError(client.ErrGraphqlResultIsEmpty, "read", "resource"), expected: client.ErrorKindUnknown},
+		{err: client.NewAPIError(client.NewHTTPError("/", 404, nil), "read", "resource"), expected: client.ErrorKindNotFound},
+		{err: client.NewAPIError(client.NewHTTPError("/", 403, nil), "read", "resource"), expected: client.ErrorKindPermissionDenied},
+		{err: client.NewAPIError(client.NewHTTPError("/", 422, nil), "read", "resource"), expected: client.ErrorKindValidation},
+		{err: client.NewAPIError(client.NewHTTPError("/", 409, nil), "read", "resource"), expected: client.ErrorKindConflict},
+		{err: client.NewAPIError(client.NewHTTPError("/", 429, nil), "read", "resource"), expected: client.ErrorKindThrottled},
+		{err: client.NewAPIError(client.NewHTTPError("/", 503, nil), "read", "resource"), expected: client.ErrorKindServerError},
+		{err: client.NewAPIError(client.NewRequestError(errBadRequest, client.ErrorKindConflict, 0), "update", "resource"), expected: client.ErrorKindConflict},
+		{err: client.NewAPIError(client.NewThrottledError(errBadRequest, 0), "update", "resource"), expected: client.ErrorKindThrottled},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.expected, client.ErrorKindOf(c.err), c.err.Error())
+	}
+}
+
+func TestClientGraphqlErrorKind(t *testing.T) {
+	cases := []struct {
+		code     string
+		expected client.ErrorKind
+	}{
+		{code: "NOT_FOUND", expected: client.ErrorKindNotFound},
+		{code: "FORBIDDEN", expected: client.ErrorKindPermissionDenied},
+		{code: "BAD_USER_INPUT", expected: client.ErrorKindValidation},
+		{code: "conflict", expected: client.ErrorKindConflict},
+		{code: "THROTTLED", expected: client.ErrorKindThrottled},
+		{code: "INTERNAL_SERVER_ERROR", expected: client.ErrorKindServerError},
+		{code: "UNKNOWN_CODE", expected: client.ErrorKindUnknown},
+	}
+
+	for _, c := range cases {
+		t.Run("Test Soc2bd Resource : Client Graphql Error Kind "+c.code, func(t *testing.T) {
+			jsonResponse := fmt.Sprintf(`{
+			  "errors": [
+			    {
+			      "message": "request failed",
+			      "extensions": {
+			        "code": "%s"
+			      }
+			    }
+			  ]
+			}`, c.code)
+
+			c1 := newHTTPMockClient()
+			defer httpmock.DeactivateAndReset()
+			httpmock.RegisterResponder("POST", c1.GraphqlServerURL,
+				httpmock.NewStringResponder(200, jsonResponse))
+
+			resource, err := c1.ReadResource(context.Background(), "resource-id")
+
+			assert.Nil(t, resource)
+			assert.Equal(t, c.expected, client.ErrorKindOf(err))
+			assert.Equal(t, c.expected == client.ErrorKindNotFound, client.IsNotFound(err))
+		})
+	}
+}
@@ -6,8 +6,8 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 )
@@ -15,8 +15,9 @@ import (
 func TestClientGroupCreateOk(t *testing.T) {
 	t.Run("Test Soc2bd Resource : Create Group Ok", func(t *testing.T) {
 		expected := &model.Group{
-			ID:   "test-id",
-			Name: "test",
+			ID:        "test-id",
+			Name:      "test",
+			Resources: []string{},
 		}
 
 		jsonResponse := `{
@@ -257,11 +258,12 @@ func TestClientGroupUpdateWithEmptyID(t *testing.T) {
 func TestClientGroupReadOk(t *testing.T) {
 	t.Run("Test Soc2bd Resource : Read Group Ok", func(t *testing.T) {
 		expected := &model.Group{
-			ID:       "id",
-			Name:     "name",
-			Type:     "MANUAL",
-			IsActive: true,
-			Users:    []string{},
+			ID:        "id",
+			Name:      "name",
+			Type:      "MANUAL",
+			IsActive:  true,
+			Users:     []string{},
+			Resources: []string{},
 		}
 
 		jsonResponse := `{
@@ -435,11 +437,12 @@ func TestClientGroupReadEmptyOnFetchPages(t *testing.T) {
 func TestClientGroupReadOkOnFetchPages(t *testing.T) {
 	t.Run("Test Soc2bd Resource : Read Group Error On Fetch Pages", func(t *testing.T) {
 		expected := &model.Group{
-			ID:       "group-id",
-			Name:     "name",
-			Type:     "MANUAL",
-			IsActive: true,
-			Users:    []string{"user-1", "user-2"},
+			ID:        "group-id",
+			Name:      "name",
+			Type:      "MANUAL",
+			IsActive:  true,
+			Users:     []string{"user-1", "user-2"},
+			Resources: []string{},
 		}
 
 		response1 := `{
@@ -585,19 +588,22 @@ func TestClientGroupsReadOk(t *testing.T) {
 	t.Run("Test Soc2bd Resource : Read Groups Ok", func(t *testing.T) {
 		expected := []*model.Group{
 			{
-				ID:    "id1",
-				Name:  "group1",
-				Users: []string{},
+				ID:        "id1",
+				Name:      "group1",
+				Users:     []string{},
+				Resources: []string{},
 			},
 			{
-				ID:    "id2",
-				Name:  "group2",
-				Users: []string{},
+				ID:        "id2",
+				Name:      "group2",
+				Users:     []string{},
+				Resources: []string{},
 			},
 			{
-				ID:    "id3",
-				Name:  "group3",
-				Users: []string{},
+				ID:        "id3",
+				Name:      "group3",
+				Users:     []string{},
+				Resources: []string{},
 			},
 		}
 
@@ -640,6 +646,57 @@ func TestClientGroupsReadOk(t *testing.T) {
 	})
 }
 
+func TestClientShallowGroupsReadOk(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Read Shallow Groups Ok", func(t *testing.T) {
+		expected := []*model.Group{
+			{ID: "id1", Name: "group1", Type: model.GroupTypeManual, IsActive: true},
+			{ID: "id2", Name: "group2", Type: model.GroupTypeSystem, IsActive: true, SecurityPolicyID: "policy-id"},
+		}
+
+		jsonResponse := `{
+		  "data": {
+		    "groups": {
+		      "pageInfo": {
+		        "hasNextPage": false
+		      },
+		      "edges": [
+		        {
+		          "node": {
+		            "id": "id1",
+		            "name": "group1",
+		            "type": "MANUAL",
+		            "isActive": true
+		          }
+		        },
+		        {
+		          "node": {
+		            "id": "id2",
+		            "name": "group2",
+		            "type": "SYSTEM",
+		            "isActive": true,
+		            "securityPolicy": {
+		              "id": "policy-id"
+		            }
+		          }
+		        }
+		      ]
+		    }
+		  }
+		}`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(200, jsonResponse))
+
+		groups, err := c.ReadShallowGroups(context.Background(), &model.GroupsFilter{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, groups)
+		assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	})
+}
+
 func TestClientGroupsReadError(t *testing.T) {
 	t.Run("Test Soc2bd Resource : Read Groups Error", func(t *testing.T) {
 		emptyResponse := `{
@@ -753,9 +810,9 @@ func TestClientGroupsReadEmptyResultOnFetching(t *testing.T) {
 func TestClientGroupsReadAllOk(t *testing.T) {
 	t.Run("Test Soc2bd Resource : Read Groups All - Ok", func(t *testing.T) {
 		expected := []*model.Group{
-			{ID: "id-1", Name: "group-1", Users: []string{}},
-			{ID: "id-2", Name: "group-2", Users: []string{}},
-			{ID: "id-3", Name: "group-3", Users: []string{}},
+			{ID: "id-1", Name: "group-1", Users: []string{}, Resources: []string{}},
+			{ID: "id-2", Name: "group-2", Users: []string{}, Resources: []string{}},
+			{ID: "id-3", Name: "group-3", Users: []string{}, Resources: []string{}},
 		}
 
 		jsonResponse := `{
@@ -821,9 +878,9 @@ func TestClientGroupsReadAllOk(t *testing.T) {
 func TestClientGroupsReadByNameOk(t *testing.T) {
 	t.Run("Test Soc2bd Resource : Read Groups By Name - Ok", func(t *testing.T) {
 		expected := []*model.Group{
-			{ID: "id-1", Name: "group-1", Users: []string{}},
-			{ID: "id-2", Name: "group-2", Users: []string{}},
-			{ID: "id-3", Name: "group-3", Users: []string{}},
+			{ID: "id-1", Name: "group-1", Users: []string{}, Resources: []string{}},
+			{ID: "id-2", Name: "group-2", Users: []string{}, Resources: []string{}},
+			{ID: "id-3", Name: "group-3", Users: []string{}, Resources: []string{}},
 		}
 
 		jsonResponse := `{
@@ -1154,3 +1211,15 @@ func TestClientDeleteGroupUsersEmptyResponse(t *testing.T) {
 		assert.EqualError(t, err, `failed to update group with id group-1: query result is empty`)
 	})
 }
+
+func TestClientReadGroupResourcesWithEmptyID(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Read Group Resources - Empty ID", func(t *testing.T) {
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+
+		resources, err := c.ReadGroupResources(context.Background(), "")
+
+		assert.Nil(t, resources)
+		assert.EqualError(t, err, "failed to read group: id is empty")
+	})
+}
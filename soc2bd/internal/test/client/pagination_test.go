@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client/query"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client/query"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -4,7 +4,7 @@ import (
 	"context"
 	"testing"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 )
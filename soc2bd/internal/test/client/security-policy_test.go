@@ -6,7 +6,7 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 )
@@ -353,3 +353,140 @@ func TestClientSecurityPoliciesReadEmptyResultOnFetching(t *testing.T) {
 
 	})
 }
+
+func TestClientSecurityPolicyCreateOk(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Create Security Policy Ok", func(t *testing.T) {
+		expected := &model.SecurityPolicy{
+			ID:                   "id",
+			Name:                 "name",
+			RequireMFA:           true,
+			MFAMethods:           []string{model.MFAMethodTOTP},
+			SessionLifetimeHours: 12,
+			DevicePosture: &model.DevicePosture{
+				RequireDiskEncryption:   true,
+				AllowedOperatingSystems: []string{model.OperatingSystemMacOS},
+			},
+			Groups: []string{"group-1"},
+		}
+
+		jsonResponse := `{
+		  "data": {
+		    "securityPolicyCreate": {
+		      "entity": {
+		        "id": "id",
+		        "name": "name",
+		        "requireMfa": true,
+		        "mfaMethods": ["TOTP"],
+		        "sessionLifetimeHours": 12,
+		        "devicePosture": {
+		          "requireScreenLock": false,
+		          "requireDiskEncryption": true,
+		          "requireFirewall": false,
+		          "allowedOperatingSystems": ["MACOS"]
+		        },
+		        "groups": {
+		          "pageInfo": {
+		            "hasNextPage": false
+		          },
+		          "edges": [
+		            {
+		              "node": {
+		                "id": "group-1"
+		              }
+		            }
+		          ]
+		        }
+		      },
+		      "ok": true,
+		      "error": null
+		    }
+		  }
+		}`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(http.StatusOK, jsonResponse))
+
+		policy, err := c.CreateSecurityPolicy(context.Background(), &model.SecurityPolicy{
+			Name:                 "name",
+			RequireMFA:           true,
+			MFAMethods:           []string{model.MFAMethodTOTP},
+			SessionLifetimeHours: 12,
+			DevicePosture:        expected.DevicePosture,
+			Groups:               []string{"group-1"},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, policy)
+	})
+}
+
+func TestClientSecurityPolicyCreateError(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Create Security Policy Error", func(t *testing.T) {
+		jsonResponse := `{
+		  "data": {
+		    "securityPolicyCreate": {
+		      "ok": false,
+		      "error": "error_1"
+		    }
+		  }
+		}`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(http.StatusOK, jsonResponse))
+
+		policy, err := c.CreateSecurityPolicy(context.Background(), &model.SecurityPolicy{Name: "name"})
+
+		assert.EqualError(t, err, "failed to create security policy with name name: error_1")
+		assert.Nil(t, policy)
+	})
+}
+
+func TestClientSecurityPolicyCreateWithEmptyName(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Create Security Policy With Empty Name", func(t *testing.T) {
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+
+		policy, err := c.CreateSecurityPolicy(context.Background(), &model.SecurityPolicy{})
+
+		assert.EqualError(t, err, "failed to create security policy: name is empty")
+		assert.Nil(t, policy)
+	})
+}
+
+func TestClientSecurityPolicyUpdateWithEmptyID(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Update Security Policy With Empty ID", func(t *testing.T) {
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+
+		_, err := c.UpdateSecurityPolicy(context.Background(), &model.SecurityPolicy{Name: "name"})
+
+		assert.EqualError(t, err, "failed to update security policy: id is empty")
+	})
+}
+
+func TestClientDeleteSecurityPolicyGroupsWithoutGroups(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Delete Security Policy Groups - Without Groups", func(t *testing.T) {
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+
+		err := c.DeleteSecurityPolicyGroups(context.Background(), "", nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, httpmock.GetTotalCallCount())
+	})
+}
+
+func TestClientDeleteSecurityPolicyWithEmptyID(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Delete Security Policy With Empty ID", func(t *testing.T) {
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+
+		err := c.DeleteSecurityPolicy(context.Background(), "")
+
+		assert.EqualError(t, err, "failed to delete security policy: id is empty")
+	})
+}
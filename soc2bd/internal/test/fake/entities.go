@@ -0,0 +1,286 @@
+package fake
+
+import (
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+)
+
+func (s *store) remoteNetworkObject(network *remoteNetwork) object {
+	if network == nil {
+		return nil
+	}
+
+	return object{
+		"id":       network.id,
+		"name":     network.name,
+		"location": network.location,
+		"isActive": network.isActive,
+		"connectors": resolver(func(args map[string]any) any {
+			return connection(s.connectorObjects(s.connectorsByNetwork(network.id)), args)
+		}),
+		"resources": resolver(func(args map[string]any) any {
+			return connection(s.resourceObjects(s.resourcesByNetwork(network.id)), args)
+		}),
+	}
+}
+
+func (s *store) remoteNetworkObjects(networks []*remoteNetwork) []object {
+	res := make([]object, 0, len(networks))
+	for _, network := range networks {
+		res = append(res, s.remoteNetworkObject(network))
+	}
+
+	return res
+}
+
+func (s *store) connectorObject(conn *connector) object {
+	if conn == nil {
+		return nil
+	}
+
+	return object{
+		"id":   conn.id,
+		"name": conn.name,
+		"remoteNetwork": resolver(func(map[string]any) any {
+			return s.remoteNetworkObject(s.remoteNetwork(conn.remoteNetworkID))
+		}),
+		"hasStatusNotificationsEnabled": conn.hasStatusNotificationsEnabled,
+		"state":                         conn.state,
+		"lastHeartbeatAt":               conn.lastHeartbeatAt,
+		"version":                       conn.version,
+		"hostname":                      conn.hostname,
+		"publicIP":                      conn.publicIP,
+		"privateIPs":                    conn.privateIPs,
+	}
+}
+
+func (s *store) connectorObjects(connectors []*connector) []object {
+	res := make([]object, 0, len(connectors))
+	for _, conn := range connectors {
+		res = append(res, s.connectorObject(conn))
+	}
+
+	return res
+}
+
+func (s *store) groupObject(grp *group) object {
+	if grp == nil {
+		return nil
+	}
+
+	return object{
+		"id":       grp.id,
+		"name":     grp.name,
+		"type":     grp.groupType,
+		"isActive": grp.isActive,
+		"users": resolver(func(args map[string]any) any {
+			users := make([]*user, 0, len(grp.users))
+			for _, id := range grp.users {
+				if usr := s.user(id); usr != nil {
+					users = append(users, usr)
+				}
+			}
+
+			return connection(s.userObjects(users), args)
+		}),
+		"resources": resolver(func(args map[string]any) any {
+			var resources []*resource
+			for _, res := range s.resources {
+				if contains(res.groups, grp.id) {
+					resources = append(resources, res)
+				}
+			}
+
+			return connection(s.resourceObjects(resources), args)
+		}),
+		"securityPolicy": resolver(func(map[string]any) any {
+			return s.securityPolicyObject(s.securityPolicy(grp.securityPolicyID))
+		}),
+	}
+}
+
+func (s *store) groupObjects(groups []*group) []object {
+	res := make([]object, 0, len(groups))
+	for _, grp := range groups {
+		res = append(res, s.groupObject(grp))
+	}
+
+	return res
+}
+
+func (s *store) resourceObject(res *resource) object {
+	if res == nil {
+		return nil
+	}
+
+	return object{
+		"id":   res.id,
+		"name": res.name,
+		"address": object{
+			"value": res.address,
+		},
+		"remoteNetwork": resolver(func(map[string]any) any {
+			return s.remoteNetworkObject(s.remoteNetwork(res.remoteNetworkID))
+		}),
+		"protocols":                res.protocols,
+		"isActive":                 res.isActive,
+		"isVisible":                res.isVisible,
+		"isBrowserShortcutEnabled": res.isBrowserShortcutEnabled,
+		"alias":                    res.alias,
+		"groups": resolver(func(args map[string]any) any {
+			groups := make([]*group, 0, len(res.groups))
+			for _, id := range res.groups {
+				if grp := s.group(id); grp != nil {
+					groups = append(groups, grp)
+				}
+			}
+
+			return connection(s.groupObjects(groups), args)
+		}),
+		"serviceAccounts": resolver(func(args map[string]any) any {
+			accounts := make([]*serviceAccount, 0, len(res.serviceAccounts))
+			for _, id := range res.serviceAccounts {
+				if account := s.serviceAccount(id); account != nil {
+					accounts = append(accounts, account)
+				}
+			}
+
+			return connection(s.serviceAccountObjects(accounts), args)
+		}),
+	}
+}
+
+func (s *store) resourceObjects(resources []*resource) []object {
+	res := make([]object, 0, len(resources))
+	for _, item := range resources {
+		res = append(res, s.resourceObject(item))
+	}
+
+	return res
+}
+
+func (s *store) serviceAccountObject(account *serviceAccount) object {
+	if account == nil {
+		return nil
+	}
+
+	return object{
+		"id":   account.id,
+		"name": account.name,
+		"resources": resolver(func(args map[string]any) any {
+			return connection(s.resourceObjects(s.resourcesByServiceAccount(account.id)), args)
+		}),
+		"keys": resolver(func(args map[string]any) any {
+			return connection(s.serviceKeyObjects(s.serviceKeysByAccount(account.id)), args)
+		}),
+	}
+}
+
+func (s *store) serviceAccountObjects(accounts []*serviceAccount) []object {
+	res := make([]object, 0, len(accounts))
+	for _, account := range accounts {
+		res = append(res, s.serviceAccountObject(account))
+	}
+
+	return res
+}
+
+func (s *store) serviceKeyObject(key *serviceKey) object {
+	if key == nil {
+		return nil
+	}
+
+	return object{
+		"id":        key.id,
+		"name":      key.name,
+		"expiresAt": key.expiresAt,
+		"status":    key.status,
+		"serviceAccount": resolver(func(map[string]any) any {
+			return s.serviceAccountObject(s.serviceAccount(key.serviceAccountID))
+		}),
+	}
+}
+
+func (s *store) serviceKeyObjects(keys []*serviceKey) []object {
+	res := make([]object, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, s.serviceKeyObject(key))
+	}
+
+	return res
+}
+
+func (s *store) userObject(usr *user) object {
+	if usr == nil {
+		return nil
+	}
+
+	return object{
+		"id":        usr.id,
+		"firstName": usr.firstName,
+		"lastName":  usr.lastName,
+		"email":     usr.email,
+		"role":      usr.role,
+		"type":      usr.userType,
+		"state":     usr.state,
+		"groups": resolver(func(args map[string]any) any {
+			var groups []*group
+			for _, grp := range s.groups {
+				if contains(grp.users, usr.id) {
+					groups = append(groups, grp)
+				}
+			}
+
+			return connection(s.groupObjects(groups), args)
+		}),
+	}
+}
+
+func (s *store) userObjects(users []*user) []object {
+	res := make([]object, 0, len(users))
+	for _, usr := range users {
+		res = append(res, s.userObject(usr))
+	}
+
+	return res
+}
+
+func (s *store) securityPolicyObject(policy *securityPolicy) object {
+	if policy == nil {
+		return nil
+	}
+
+	return object{
+		"id":                   policy.id,
+		"name":                 policy.name,
+		"requireMfa":           policy.requireMFA,
+		"mfaMethods":           policy.mfaMethods,
+		"sessionLifetimeHours": policy.sessionLifetimeHours,
+		"devicePosture":        policy.devicePosture,
+		"groups": resolver(func(args map[string]any) any {
+			return connection(s.groupObjects(s.groupsBySecurityPolicy(policy.id)), args)
+		}),
+	}
+}
+
+func (s *store) securityPolicyObjects(policies []*securityPolicy) []object {
+	res := make([]object, 0, len(policies))
+	for _, policy := range policies {
+		res = append(res, s.securityPolicyObject(policy))
+	}
+
+	return res
+}
+
+func defaultProtocols() map[string]any {
+	return map[string]any{
+		"allowIcmp": true,
+		"tcp": map[string]any{
+			"policy": model.PolicyAllowAll,
+			"ports":  []any{},
+		},
+		"udp": map[string]any{
+			"policy": model.PolicyAllowAll,
+			"ports":  []any{},
+		},
+	}
+}
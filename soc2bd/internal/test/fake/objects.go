@@ -0,0 +1,262 @@
+package fake
+
+import (
+	"encoding/base64"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// object is a GraphQL object value. Fields may hold scalars, nested objects,
+// lists or resolvers; resolvers are evaluated lazily with the field arguments
+// during projection, so cyclic references between entities are fine.
+type object = map[string]any
+
+type resolver func(args map[string]any) any
+
+// project shapes the value according to the requested selection set:
+// go-graphql-client fails on unknown fields, so only selected ones are returned.
+func project(val any, sel *field, variables map[string]any) any {
+	if fn, ok := val.(resolver); ok {
+		val = fn(sel.args(variables))
+	}
+
+	switch value := val.(type) {
+	case object:
+		if value == nil {
+			return nil
+		}
+
+		if len(sel.fields) == 0 {
+			return value
+		}
+
+		res := make(object, len(sel.fields))
+		for _, sub := range sel.fields {
+			res[sub.responseKey()] = project(value[sub.name], sub, variables)
+		}
+
+		return res
+	case []object:
+		res := make([]any, 0, len(value))
+		for _, item := range value {
+			res = append(res, project(item, sel, variables))
+		}
+
+		return res
+	case []any:
+		res := make([]any, 0, len(value))
+		for _, item := range value {
+			res = append(res, project(item, sel, variables))
+		}
+
+		return res
+	default:
+		return value
+	}
+}
+
+func encodeCursor(offset int) string {
+	return base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) int {
+	data, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0
+	}
+
+	offset, err := strconv.Atoi(string(data))
+	if err != nil || offset < 0 {
+		return 0
+	}
+
+	return offset
+}
+
+// connection builds a relay style connection page from the full node list,
+// honoring `after` and `first` arguments.
+func connection(nodes []object, args map[string]any) object {
+	start := 0
+	if after := argString(args, "after"); after != "" {
+		start = decodeCursor(after)
+	}
+
+	if start > len(nodes) {
+		start = len(nodes)
+	}
+
+	end := len(nodes)
+	if first, ok := argInt(args, "first"); ok && first > 0 && start+first < end {
+		end = start + first
+	}
+
+	edges := make([]object, 0, end-start)
+	for _, node := range nodes[start:end] {
+		edges = append(edges, object{"node": node, "cursor": encodeCursor(start + len(edges) + 1)})
+	}
+
+	return object{
+		"pageInfo": object{
+			"endCursor":   encodeCursor(end),
+			"hasNextPage": end < len(nodes),
+		},
+		"edges": edges,
+	}
+}
+
+// matchFilter evaluates a GraphQL filter input like `{name: {eq: "x"}}`
+// against the object's scalar fields.
+func matchFilter(obj object, filter map[string]any) bool {
+	for key, raw := range filter {
+		ops, ok := raw.(map[string]any)
+		if !ok || ops == nil {
+			continue
+		}
+
+		if !matchOperations(obj[key], ops) {
+			return false
+		}
+	}
+
+	return true
+}
+
+//nolint:cyclop
+func matchOperations(val any, ops map[string]any) bool {
+	if nested, ok := val.(object); ok {
+		return matchFilter(nested, ops)
+	}
+
+	str := toString(val)
+
+	for op, expected := range ops {
+		if expected == nil {
+			continue
+		}
+
+		switch op {
+		case "eq":
+			if str != toString(expected) {
+				return false
+			}
+		case "ne":
+			if str == toString(expected) {
+				return false
+			}
+		case "in":
+			if !contains(toStrings(expected), str) {
+				return false
+			}
+		case "notIn":
+			if contains(toStrings(expected), str) {
+				return false
+			}
+		case "startsWith":
+			if !strings.HasPrefix(str, toString(expected)) {
+				return false
+			}
+		case "endsWith":
+			if !strings.HasSuffix(str, toString(expected)) {
+				return false
+			}
+		case "contains":
+			if !strings.Contains(str, toString(expected)) {
+				return false
+			}
+		case "regexp":
+			if matched, err := regexp.MatchString(toString(expected), str); err != nil || !matched {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func filterObjects(nodes []object, filter map[string]any) []object {
+	if len(filter) == 0 {
+		return nodes
+	}
+
+	res := make([]object, 0, len(nodes))
+
+	for _, node := range nodes {
+		if matchFilter(node, filter) {
+			res = append(res, node)
+		}
+	}
+
+	return res
+}
+
+func toString(val any) string {
+	switch value := val.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case bool:
+		return strconv.FormatBool(value)
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(value)
+	default:
+		return ""
+	}
+}
+
+func toStrings(val any) []string {
+	list, ok := val.([]any)
+	if !ok {
+		return nil
+	}
+
+	res := make([]string, 0, len(list))
+	for _, item := range list {
+		res = append(res, toString(item))
+	}
+
+	return res
+}
+
+func argString(args map[string]any, key string) string {
+	return toString(args[key])
+}
+
+func argStringPtr(args map[string]any, key string) *string {
+	val, ok := args[key].(string)
+	if !ok {
+		return nil
+	}
+
+	return &val
+}
+
+func argBool(args map[string]any, key string) (bool, bool) {
+	val, ok := args[key].(bool)
+
+	return val, ok
+}
+
+func argInt(args map[string]any, key string) (int, bool) {
+	switch val := args[key].(type) {
+	case float64:
+		return int(val), true
+	case int:
+		return val, true
+	default:
+		return 0, false
+	}
+}
+
+func argStrings(args map[string]any, key string) []string {
+	return toStrings(args[key])
+}
+
+func argObject(args map[string]any, key string) map[string]any {
+	val, _ := args[key].(map[string]any)
+
+	return val
+}
@@ -0,0 +1,407 @@
+package fake
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var ErrUnexpectedToken = errors.New("unexpected token")
+
+const (
+	operationQuery    = "query"
+	operationMutation = "mutation"
+)
+
+// document is a parsed GraphQL request: only the subset of the language
+// generated by go-graphql-client is supported.
+type document struct {
+	operation string
+	name      string
+	fields    []*field
+}
+
+type field struct {
+	alias     string
+	name      string
+	arguments map[string]value
+	fields    []*field
+}
+
+func (f *field) responseKey() string {
+	if f.alias != "" {
+		return f.alias
+	}
+
+	return f.name
+}
+
+// value is a parsed argument value: either a literal or a variable reference.
+type value interface {
+	resolve(variables map[string]any) any
+}
+
+type literal struct {
+	val any
+}
+
+func (l literal) resolve(map[string]any) any {
+	return l.val
+}
+
+type variable string
+
+func (v variable) resolve(variables map[string]any) any {
+	return variables[string(v)]
+}
+
+type listValue []value
+
+func (l listValue) resolve(variables map[string]any) any {
+	res := make([]any, 0, len(l))
+	for _, item := range l {
+		res = append(res, item.resolve(variables))
+	}
+
+	return res
+}
+
+type objectValue map[string]value
+
+func (o objectValue) resolve(variables map[string]any) any {
+	res := make(map[string]any, len(o))
+	for key, item := range o {
+		res[key] = item.resolve(variables)
+	}
+
+	return res
+}
+
+func (f *field) args(variables map[string]any) map[string]any {
+	res := make(map[string]any, len(f.arguments))
+	for key, val := range f.arguments {
+		res[key] = val.resolve(variables)
+	}
+
+	return res
+}
+
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func parseDocument(src string) (*document, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+
+	return p.document()
+}
+
+func (p *parser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() string {
+	tok := p.peek()
+	p.pos++
+
+	return tok
+}
+
+func (p *parser) expect(tok string) error {
+	if got := p.next(); got != tok {
+		return fmt.Errorf("%w: expected %q, got %q", ErrUnexpectedToken, tok, got)
+	}
+
+	return nil
+}
+
+func (p *parser) document() (*document, error) {
+	doc := &document{operation: operationQuery}
+
+	if tok := p.peek(); tok == operationQuery || tok == operationMutation {
+		doc.operation = p.next()
+
+		if tok := p.peek(); tok != "{" && tok != "(" {
+			doc.name = p.next()
+		}
+
+		if p.peek() == "(" {
+			p.skipBlock("(", ")")
+		}
+	}
+
+	fields, err := p.selectionSet()
+	if err != nil {
+		return nil, err
+	}
+
+	doc.fields = fields
+
+	return doc, nil
+}
+
+func (p *parser) skipBlock(open, closing string) {
+	depth := 0
+
+	for p.pos < len(p.tokens) {
+		switch p.next() {
+		case open:
+			depth++
+		case closing:
+			depth--
+		}
+
+		if depth == 0 {
+			return
+		}
+	}
+}
+
+func (p *parser) selectionSet() ([]*field, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	var fields []*field
+
+	for {
+		switch tok := p.peek(); tok {
+		case "}":
+			p.next()
+
+			return fields, nil
+		case ",":
+			p.next()
+		case "":
+			return nil, fmt.Errorf("%w: unterminated selection set", ErrUnexpectedToken)
+		case "...":
+			inline, err := p.inlineFragment()
+			if err != nil {
+				return nil, err
+			}
+
+			fields = append(fields, inline...)
+		default:
+			f, err := p.field()
+			if err != nil {
+				return nil, err
+			}
+
+			fields = append(fields, f)
+		}
+	}
+}
+
+func (p *parser) inlineFragment() ([]*field, error) {
+	p.next()
+
+	if p.peek() == "on" {
+		p.next()
+		p.next()
+	}
+
+	return p.selectionSet()
+}
+
+func (p *parser) field() (*field, error) {
+	f := &field{name: p.next()}
+
+	if p.peek() == ":" {
+		p.next()
+		f.alias = f.name
+		f.name = p.next()
+	}
+
+	if p.peek() == "(" {
+		args, err := p.arguments("(", ")")
+		if err != nil {
+			return nil, err
+		}
+
+		f.arguments = args
+	}
+
+	if p.peek() == "{" {
+		fields, err := p.selectionSet()
+		if err != nil {
+			return nil, err
+		}
+
+		f.fields = fields
+	}
+
+	return f, nil
+}
+
+func (p *parser) arguments(open, closing string) (map[string]value, error) {
+	if err := p.expect(open); err != nil {
+		return nil, err
+	}
+
+	args := make(map[string]value)
+
+	for {
+		tok := p.next()
+
+		switch tok {
+		case closing:
+			return args, nil
+		case ",":
+			continue
+		case "":
+			return nil, fmt.Errorf("%w: unterminated arguments", ErrUnexpectedToken)
+		}
+
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+
+		val, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+
+		args[tok] = val
+	}
+}
+
+func (p *parser) value() (value, error) {
+	switch tok := p.peek(); {
+	case tok == "$":
+		p.next()
+
+		return variable(p.next()), nil
+	case tok == "{":
+		args, err := p.arguments("{", "}")
+		if err != nil {
+			return nil, err
+		}
+
+		return objectValue(args), nil
+	case tok == "[":
+		return p.list()
+	default:
+		return p.scalar(p.next())
+	}
+}
+
+func (p *parser) list() (value, error) {
+	p.next()
+
+	var list listValue
+
+	for {
+		switch p.peek() {
+		case "]":
+			p.next()
+
+			return list, nil
+		case ",":
+			p.next()
+		case "":
+			return nil, fmt.Errorf("%w: unterminated list", ErrUnexpectedToken)
+		default:
+			val, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+
+			list = append(list, val)
+		}
+	}
+}
+
+func (p *parser) scalar(tok string) (value, error) {
+	switch {
+	case tok == "true" || tok == "false":
+		return literal{val: tok == "true"}, nil
+	case tok == "null":
+		return literal{}, nil
+	case strings.HasPrefix(tok, `"`):
+		str, err := strconv.Unquote(tok)
+		if err != nil {
+			return nil, fmt.Errorf("invalid string %s: %w", tok, err)
+		}
+
+		return literal{val: str}, nil
+	}
+
+	if num, err := strconv.ParseFloat(tok, 64); err == nil {
+		return literal{val: num}, nil
+	}
+
+	// enum value
+	return literal{val: tok}, nil
+}
+
+func tokenize(src string) ([]string, error) {
+	var tokens []string
+
+	runes := []rune(src)
+
+	for i := 0; i < len(runes); {
+		char := runes[i]
+
+		switch {
+		case unicode.IsSpace(char):
+			i++
+		case char == '#':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case strings.ContainsRune("{}()[]:,$!=@", char):
+			tokens = append(tokens, string(char))
+			i++
+		case char == '.':
+			if i+2 >= len(runes) || string(runes[i:i+3]) != "..." {
+				return nil, fmt.Errorf("%w: %q", ErrUnexpectedToken, char)
+			}
+
+			tokens = append(tokens, "...")
+			i += 3
+		case char == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				if runes[end] == '\\' {
+					end++
+				}
+				end++
+			}
+
+			if end >= len(runes) {
+				return nil, fmt.Errorf("%w: unterminated string", ErrUnexpectedToken)
+			}
+
+			tokens = append(tokens, string(runes[i:end+1]))
+			i = end + 1
+		case isNameChar(char) || char == '-':
+			end := i + 1
+			for end < len(runes) && (isNameChar(runes[end]) || runes[end] == '.') {
+				end++
+			}
+
+			tokens = append(tokens, string(runes[i:end]))
+			i = end
+		default:
+			return nil, fmt.Errorf("%w: %q", ErrUnexpectedToken, char)
+		}
+	}
+
+	return tokens, nil
+}
+
+func isNameChar(char rune) bool {
+	return char == '_' || unicode.IsLetter(char) || unicode.IsDigit(char)
+}
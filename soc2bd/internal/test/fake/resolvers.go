@@ -0,0 +1,728 @@
+package fake
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/go-uuid"
+)
+
+// rootResolver handles a top level query or mutation field.
+type rootResolver func(args map[string]any) any
+
+func okPayload(fields object) object {
+	payload := object{
+		"ok":    true,
+		"error": nil,
+	}
+
+	for key, val := range fields {
+		payload[key] = val
+	}
+
+	return payload
+}
+
+func errPayload(format string, args ...any) object {
+	return object{
+		"ok":    false,
+		"error": fmt.Sprintf(format, args...),
+	}
+}
+
+func notFound(typeName, id string) object {
+	return errPayload("%s with id %s not found", typeName, id)
+}
+
+func (s *store) queries() map[string]rootResolver {
+	return map[string]rootResolver{
+		"remoteNetwork": func(args map[string]any) any {
+			return s.remoteNetworkObject(s.remoteNetwork(argString(args, "id")))
+		},
+		"remoteNetworks": func(args map[string]any) any {
+			nodes := filterObjects(s.remoteNetworkObjects(s.remoteNetworks), argObject(args, "filter"))
+
+			return connection(nodes, args)
+		},
+		"connector": func(args map[string]any) any {
+			return s.connectorObject(s.connector(argString(args, "id")))
+		},
+		"connectors": func(args map[string]any) any {
+			return connection(filterObjects(s.connectorObjects(s.connectors), argObject(args, "filter")), args)
+		},
+		"group": func(args map[string]any) any {
+			return s.groupObject(s.group(argString(args, "id")))
+		},
+		"groups": func(args map[string]any) any {
+			return connection(filterObjects(s.groupObjects(s.groups), argObject(args, "filter")), args)
+		},
+		"resource": func(args map[string]any) any {
+			return s.resourceObject(s.resource(argString(args, "id")))
+		},
+		"resources": func(args map[string]any) any {
+			return connection(filterObjects(s.resourceObjects(s.resources), argObject(args, "filter")), args)
+		},
+		"serviceAccount": func(args map[string]any) any {
+			return s.serviceAccountObject(s.serviceAccount(argString(args, "id")))
+		},
+		"serviceAccounts": func(args map[string]any) any {
+			return connection(filterObjects(s.serviceAccountObjects(s.serviceAccounts), argObject(args, "filter")), args)
+		},
+		"serviceAccountKey": func(args map[string]any) any {
+			return s.serviceKeyObject(s.serviceKey(argString(args, "id")))
+		},
+		"user": func(args map[string]any) any {
+			return s.userObject(s.user(argString(args, "id")))
+		},
+		"users": func(args map[string]any) any {
+			return connection(filterObjects(s.userObjects(s.users), argObject(args, "filter")), args)
+		},
+		"securityPolicy": func(args map[string]any) any {
+			if id := argString(args, "id"); id != "" {
+				return s.securityPolicyObject(s.securityPolicy(id))
+			}
+
+			return s.securityPolicyObject(s.securityPolicyByName(argString(args, "name")))
+		},
+		"securityPolicies": func(args map[string]any) any {
+			return connection(filterObjects(s.securityPolicyObjects(s.securityPolicies), argObject(args, "filter")), args)
+		},
+	}
+}
+
+func (s *store) mutations() map[string]rootResolver {
+	return map[string]rootResolver{
+		"remoteNetworkCreate":     s.remoteNetworkCreate,
+		"remoteNetworkUpdate":     s.remoteNetworkUpdate,
+		"remoteNetworkDelete":     s.remoteNetworkDelete,
+		"connectorCreate":         s.connectorCreate,
+		"connectorUpdate":         s.connectorUpdate,
+		"connectorDelete":         s.connectorDelete,
+		"connectorGenerateTokens": s.connectorGenerateTokens,
+		"groupCreate":             s.groupCreate,
+		"groupUpdate":             s.groupUpdate,
+		"groupDelete":             s.groupDelete,
+		"resourceCreate":          s.resourceCreate,
+		"resourceUpdate":          s.resourceUpdate,
+		"resourceDelete":          s.resourceDelete,
+		"serviceAccountCreate":    s.serviceAccountCreate,
+		"serviceAccountUpdate":    s.serviceAccountUpdate,
+		"serviceAccountDelete":    s.serviceAccountDelete,
+		"serviceAccountKeyCreate": s.serviceKeyCreate,
+		"serviceAccountKeyUpdate": s.serviceKeyUpdate,
+		"serviceAccountKeyRevoke": s.serviceKeyRevoke,
+		"serviceAccountKeyDelete": s.serviceKeyDelete,
+		"userCreate":              s.userCreate,
+		"userDetailsUpdate":       s.userDetailsUpdate,
+		"userRoleUpdate":          s.userRoleUpdate,
+		"userDelete":              s.userDelete,
+		"securityPolicyCreate":    s.securityPolicyCreate,
+		"securityPolicyUpdate":    s.securityPolicyUpdate,
+		"securityPolicyDelete":    s.securityPolicyDelete,
+	}
+}
+
+func (s *store) remoteNetworkCreate(args map[string]any) any {
+	name := argString(args, "name")
+	if name == "" {
+		return errPayload("name is required")
+	}
+
+	network := &remoteNetwork{
+		id:       s.nextID(typeRemoteNetwork),
+		name:     name,
+		location: model.LocationOther,
+		isActive: true,
+	}
+
+	if location := argString(args, "location"); location != "" {
+		network.location = location
+	}
+
+	if isActive, ok := argBool(args, "isActive"); ok {
+		network.isActive = isActive
+	}
+
+	s.remoteNetworks = append(s.remoteNetworks, network)
+
+	return okPayload(object{"entity": s.remoteNetworkObject(network)})
+}
+
+func (s *store) remoteNetworkUpdate(args map[string]any) any {
+	id := argString(args, "id")
+
+	network := s.remoteNetwork(id)
+	if network == nil {
+		return notFound(typeRemoteNetwork, id)
+	}
+
+	if name := argString(args, "name"); name != "" {
+		network.name = name
+	}
+
+	if location := argString(args, "location"); location != "" {
+		network.location = location
+	}
+
+	return okPayload(object{"entity": s.remoteNetworkObject(network)})
+}
+
+func (s *store) remoteNetworkDelete(args map[string]any) any {
+	id := argString(args, "id")
+	if !s.deleteRemoteNetwork(id) {
+		return notFound(typeRemoteNetwork, id)
+	}
+
+	return okPayload(nil)
+}
+
+func (s *store) connectorCreate(args map[string]any) any {
+	networkID := argString(args, "remoteNetworkId")
+	if s.remoteNetwork(networkID) == nil {
+		return notFound(typeRemoteNetwork, networkID)
+	}
+
+	id := s.nextID(typeConnector)
+
+	conn := &connector{
+		id:                            id,
+		name:                          argString(args, "name"),
+		remoteNetworkID:               networkID,
+		hasStatusNotificationsEnabled: true,
+		state:                         model.ConnectorStateDeadNoHeartbeat,
+	}
+
+	if conn.name == "" {
+		conn.name = "connector-" + strings.ToLower(strings.TrimRight(id, "="))
+	}
+
+	if enabled, ok := argBool(args, "hasStatusNotificationsEnabled"); ok {
+		conn.hasStatusNotificationsEnabled = enabled
+	}
+
+	s.connectors = append(s.connectors, conn)
+
+	return okPayload(object{"entity": s.connectorObject(conn)})
+}
+
+func (s *store) connectorUpdate(args map[string]any) any {
+	id := argString(args, "id")
+
+	conn := s.connector(id)
+	if conn == nil {
+		return notFound(typeConnector, id)
+	}
+
+	if name := argString(args, "name"); name != "" {
+		conn.name = name
+	}
+
+	if enabled, ok := argBool(args, "hasStatusNotificationsEnabled"); ok {
+		conn.hasStatusNotificationsEnabled = enabled
+	}
+
+	return okPayload(object{"entity": s.connectorObject(conn)})
+}
+
+func (s *store) connectorDelete(args map[string]any) any {
+	id := argString(args, "id")
+	if !s.deleteConnector(id) {
+		return notFound(typeConnector, id)
+	}
+
+	return okPayload(nil)
+}
+
+func (s *store) connectorGenerateTokens(args map[string]any) any {
+	id := argString(args, "connectorId")
+
+	conn := s.connector(id)
+	if conn == nil {
+		return notFound(typeConnector, id)
+	}
+
+	s.generateConnectorTokens(conn)
+
+	return okPayload(object{
+		"connectorTokens": object{
+			"accessToken":  conn.accessToken,
+			"refreshToken": conn.refreshToken,
+		},
+	})
+}
+
+func (s *store) groupCreate(args map[string]any) any {
+	name := argString(args, "name")
+	if name == "" {
+		return errPayload("name is required")
+	}
+
+	users := argStrings(args, "userIds")
+	for _, userID := range users {
+		if s.user(userID) == nil {
+			return notFound(typeUser, userID)
+		}
+	}
+
+	grp := &group{
+		id:               s.nextID(typeGroup),
+		name:             name,
+		groupType:        model.GroupTypeManual,
+		isActive:         true,
+		users:            union(nil, users...),
+		securityPolicyID: s.defaultSecurityPolicyID(),
+	}
+
+	if policyID := argString(args, "securityPolicyId"); policyID != "" {
+		if s.securityPolicy(policyID) == nil {
+			return notFound(typeSecurityPolicy, policyID)
+		}
+
+		grp.securityPolicyID = policyID
+	}
+
+	s.groups = append(s.groups, grp)
+
+	return okPayload(object{"entity": s.groupObject(grp)})
+}
+
+func (s *store) groupUpdate(args map[string]any) any {
+	id := argString(args, "id")
+
+	grp := s.group(id)
+	if grp == nil {
+		return notFound(typeGroup, id)
+	}
+
+	added := argStrings(args, "addedUserIds")
+	for _, userID := range added {
+		if s.user(userID) == nil {
+			return notFound(typeUser, userID)
+		}
+	}
+
+	if name := argString(args, "name"); name != "" {
+		grp.name = name
+	}
+
+	if policyID := argString(args, "securityPolicyId"); policyID != "" {
+		if s.securityPolicy(policyID) == nil {
+			return notFound(typeSecurityPolicy, policyID)
+		}
+
+		grp.securityPolicyID = policyID
+	}
+
+	grp.users = without(union(grp.users, added...), argStrings(args, "removedUserIds")...)
+
+	return okPayload(object{"entity": s.groupObject(grp)})
+}
+
+func (s *store) groupDelete(args map[string]any) any {
+	id := argString(args, "id")
+	if !s.deleteGroup(id) {
+		return notFound(typeGroup, id)
+	}
+
+	return okPayload(nil)
+}
+
+func (s *store) resourceCreate(args map[string]any) any {
+	name, address := argString(args, "name"), argString(args, "address")
+	if name == "" || address == "" {
+		return errPayload("name and address are required")
+	}
+
+	networkID := argString(args, "remoteNetworkId")
+	if s.remoteNetwork(networkID) == nil {
+		return notFound(typeRemoteNetwork, networkID)
+	}
+
+	res := &resource{
+		id:                       s.nextID(typeResource),
+		name:                     name,
+		address:                  address,
+		remoteNetworkID:          networkID,
+		protocols:                defaultProtocols(),
+		isActive:                 true,
+		isVisible:                true,
+		isBrowserShortcutEnabled: true,
+	}
+
+	if msg := s.applyResourceArgs(res, args); msg != "" {
+		return errPayload(msg)
+	}
+
+	s.resources = append(s.resources, res)
+
+	return okPayload(object{"entity": s.resourceObject(res)})
+}
+
+func (s *store) resourceUpdate(args map[string]any) any {
+	id := argString(args, "id")
+
+	res := s.resource(id)
+	if res == nil {
+		return notFound(typeResource, id)
+	}
+
+	if networkID := argString(args, "remoteNetworkId"); networkID != "" {
+		if s.remoteNetwork(networkID) == nil {
+			return notFound(typeRemoteNetwork, networkID)
+		}
+
+		res.remoteNetworkID = networkID
+	}
+
+	if name := argString(args, "name"); name != "" {
+		res.name = name
+	}
+
+	if address := argString(args, "address"); address != "" {
+		res.address = address
+	}
+
+	if isActive, ok := argBool(args, "isActive"); ok {
+		res.isActive = isActive
+	}
+
+	if _, ok := args["alias"]; ok {
+		res.alias = argString(args, "alias")
+	}
+
+	if msg := s.applyResourceArgs(res, args); msg != "" {
+		return errPayload(msg)
+	}
+
+	res.groups = without(union(res.groups, argStrings(args, "addedGroupIds")...), argStrings(args, "removedGroupIds")...)
+
+	return okPayload(object{"entity": s.resourceObject(res)})
+}
+
+func (s *store) applyResourceArgs(res *resource, args map[string]any) string {
+	groups := argStrings(args, "groupIds")
+	for _, groupID := range groups {
+		if s.group(groupID) == nil {
+			return fmt.Sprintf("%s with id %s not found", typeGroup, groupID)
+		}
+	}
+
+	res.groups = union(res.groups, groups...)
+
+	if protocols := argObject(args, "protocols"); protocols != nil {
+		res.protocols = protocols
+	}
+
+	if isVisible, ok := argBool(args, "isVisible"); ok {
+		res.isVisible = isVisible
+	}
+
+	if enabled, ok := argBool(args, "isBrowserShortcutEnabled"); ok {
+		res.isBrowserShortcutEnabled = enabled
+	}
+
+	if alias := argString(args, "alias"); alias != "" {
+		res.alias = alias
+	}
+
+	return ""
+}
+
+func (s *store) resourceDelete(args map[string]any) any {
+	id := argString(args, "id")
+	if !s.deleteResource(id) {
+		return notFound(typeResource, id)
+	}
+
+	return okPayload(nil)
+}
+
+func (s *store) serviceAccountCreate(args map[string]any) any {
+	name := argString(args, "name")
+	if name == "" {
+		return errPayload("name is required")
+	}
+
+	account := &serviceAccount{id: s.nextID(typeServiceAccount), name: name}
+	s.serviceAccounts = append(s.serviceAccounts, account)
+
+	return okPayload(object{"entity": s.serviceAccountObject(account)})
+}
+
+func (s *store) serviceAccountUpdate(args map[string]any) any {
+	id := argString(args, "id")
+
+	account := s.serviceAccount(id)
+	if account == nil {
+		return notFound(typeServiceAccount, id)
+	}
+
+	added := argStrings(args, "addedResourceIds")
+	for _, resourceID := range added {
+		if s.resource(resourceID) == nil {
+			return notFound(typeResource, resourceID)
+		}
+	}
+
+	if name := argString(args, "name"); name != "" {
+		account.name = name
+	}
+
+	for _, resourceID := range added {
+		res := s.resource(resourceID)
+		res.serviceAccounts = union(res.serviceAccounts, account.id)
+	}
+
+	for _, resourceID := range argStrings(args, "removedResourceIds") {
+		if res := s.resource(resourceID); res != nil {
+			res.serviceAccounts = without(res.serviceAccounts, account.id)
+		}
+	}
+
+	return okPayload(object{"entity": s.serviceAccountObject(account)})
+}
+
+func (s *store) serviceAccountDelete(args map[string]any) any {
+	id := argString(args, "id")
+	if !s.deleteServiceAccount(id) {
+		return notFound(typeServiceAccount, id)
+	}
+
+	return okPayload(nil)
+}
+
+func (s *store) serviceKeyCreate(args map[string]any) any {
+	accountID := argString(args, "serviceAccountId")
+	if s.serviceAccount(accountID) == nil {
+		return notFound(typeServiceAccount, accountID)
+	}
+
+	expirationDays, _ := argInt(args, "expirationTime")
+	if expirationDays < 0 {
+		return errPayload("expirationTime must be a positive number of days")
+	}
+
+	key := newServiceKey(s.nextID(typeServiceKey), argString(args, "name"), accountID, expirationDays)
+	s.serviceKeys = append(s.serviceKeys, key)
+
+	token, _ := uuid.GenerateUUID()
+
+	return okPayload(object{
+		"entity": s.serviceKeyObject(key),
+		"token":  token,
+	})
+}
+
+func (s *store) serviceKeyUpdate(args map[string]any) any {
+	id := argString(args, "id")
+
+	key := s.serviceKey(id)
+	if key == nil {
+		return notFound(typeServiceKey, id)
+	}
+
+	if name := argString(args, "name"); name != "" {
+		key.name = name
+	}
+
+	return okPayload(object{"entity": s.serviceKeyObject(key)})
+}
+
+func (s *store) serviceKeyRevoke(args map[string]any) any {
+	id := argString(args, "id")
+
+	key := s.serviceKey(id)
+	if key == nil {
+		return notFound(typeServiceKey, id)
+	}
+
+	key.status = model.StatusRevoked
+
+	return okPayload(nil)
+}
+
+func (s *store) serviceKeyDelete(args map[string]any) any {
+	id := argString(args, "id")
+
+	key := s.serviceKey(id)
+	if key == nil {
+		return notFound(typeServiceKey, id)
+	}
+
+	if key.status == model.StatusActive {
+		return errPayload("active key %s must be revoked before deletion", id)
+	}
+
+	s.deleteServiceKey(id)
+
+	return okPayload(nil)
+}
+
+func (s *store) userCreate(args map[string]any) any {
+	email := argString(args, "email")
+	if email == "" {
+		return errPayload("email is required")
+	}
+
+	for _, usr := range s.users {
+		if strings.EqualFold(usr.email, email) {
+			return errPayload("user with email %s already exists", email)
+		}
+	}
+
+	usr := &user{
+		id:        s.nextID(typeUser),
+		firstName: argString(args, "firstName"),
+		lastName:  argString(args, "lastName"),
+		email:     email,
+		role:      model.UserRoleMember,
+		userType:  model.UserTypeManual,
+		state:     model.UserStatePending,
+	}
+
+	if role := argString(args, "role"); role != "" {
+		usr.role = role
+	}
+
+	s.users = append(s.users, usr)
+
+	return okPayload(object{"entity": s.userObject(usr)})
+}
+
+func (s *store) userDetailsUpdate(args map[string]any) any {
+	id := argString(args, "id")
+
+	usr := s.user(id)
+	if usr == nil {
+		return notFound(typeUser, id)
+	}
+
+	if firstName := argStringPtr(args, "firstName"); firstName != nil {
+		usr.firstName = *firstName
+	}
+
+	if lastName := argStringPtr(args, "lastName"); lastName != nil {
+		usr.lastName = *lastName
+	}
+
+	if state := argString(args, "state"); state != "" {
+		usr.state = state
+	}
+
+	return okPayload(object{"entity": s.userObject(usr)})
+}
+
+func (s *store) userRoleUpdate(args map[string]any) any {
+	id := argString(args, "id")
+
+	usr := s.user(id)
+	if usr == nil {
+		return notFound(typeUser, id)
+	}
+
+	if role := argString(args, "role"); role != "" {
+		usr.role = role
+	}
+
+	return okPayload(object{"entity": s.userObject(usr)})
+}
+
+func (s *store) userDelete(args map[string]any) any {
+	id := argString(args, "id")
+	if !s.deleteUser(id) {
+		return notFound(typeUser, id)
+	}
+
+	return okPayload(nil)
+}
+
+func (s *store) securityPolicyCreate(args map[string]any) any {
+	name := argString(args, "name")
+	if name == "" {
+		return errPayload("name is required")
+	}
+
+	if s.securityPolicyByName(name) != nil {
+		return errPayload("security policy with name %s already exists", name)
+	}
+
+	policy := &securityPolicy{id: s.nextID(typeSecurityPolicy), name: name}
+
+	if msg := s.applySecurityPolicyArgs(policy, args); msg != "" {
+		return errPayload(msg)
+	}
+
+	s.securityPolicies = append(s.securityPolicies, policy)
+
+	return okPayload(object{"entity": s.securityPolicyObject(policy)})
+}
+
+func (s *store) securityPolicyUpdate(args map[string]any) any {
+	id := argString(args, "id")
+
+	policy := s.securityPolicy(id)
+	if policy == nil {
+		return notFound(typeSecurityPolicy, id)
+	}
+
+	if name := argString(args, "name"); name != "" {
+		policy.name = name
+	}
+
+	if msg := s.applySecurityPolicyArgs(policy, args); msg != "" {
+		return errPayload(msg)
+	}
+
+	for _, groupID := range argStrings(args, "removedGroupIds") {
+		if grp := s.group(groupID); grp != nil && grp.securityPolicyID == policy.id {
+			grp.securityPolicyID = s.defaultSecurityPolicyID()
+		}
+	}
+
+	return okPayload(object{"entity": s.securityPolicyObject(policy)})
+}
+
+func (s *store) applySecurityPolicyArgs(policy *securityPolicy, args map[string]any) string {
+	groups := append(argStrings(args, "groupIds"), argStrings(args, "addedGroupIds")...)
+	for _, groupID := range groups {
+		if s.group(groupID) == nil {
+			return fmt.Sprintf("%s with id %s not found", typeGroup, groupID)
+		}
+	}
+
+	for _, groupID := range groups {
+		s.group(groupID).securityPolicyID = policy.id
+	}
+
+	if requireMFA, ok := argBool(args, "requireMfa"); ok {
+		policy.requireMFA = requireMFA
+	}
+
+	if _, ok := args["mfaMethods"]; ok {
+		policy.mfaMethods = argStrings(args, "mfaMethods")
+	}
+
+	if hours, ok := argInt(args, "sessionLifetimeHours"); ok {
+		policy.sessionLifetimeHours = hours
+	}
+
+	if _, ok := args["devicePosture"]; ok {
+		policy.devicePosture = argObject(args, "devicePosture")
+	}
+
+	return ""
+}
+
+func (s *store) securityPolicyDelete(args map[string]any) any {
+	id := argString(args, "id")
+	if id == s.defaultSecurityPolicyID() {
+		return errPayload("the default security policy can't be deleted")
+	}
+
+	if !s.deleteSecurityPolicy(id) {
+		return notFound(typeSecurityPolicy, id)
+	}
+
+	return okPayload(nil)
+}
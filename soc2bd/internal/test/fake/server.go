@@ -0,0 +1,248 @@
+// Package fake provides an offline, in-memory implementation of the Soc2bd
+// API, good enough to run the provider and its acceptance tests against.
+//
+// The server understands the GraphQL operations issued by the client package
+// (remote networks, connectors, groups, resources, users, service accounts,
+// service account keys and security policies) and the connector tokens
+// validation endpoint.
+package fake
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+)
+
+const (
+	GraphqlPath        = "/api/graphql/"
+	ValidateTokensPath = "/api/v4/connector/validate_tokens"
+
+	// APIToken is the only api token accepted by the fake server.
+	APIToken = "fake-api-token" //#nosec
+
+	// Network is the network name to configure the provider with.
+	Network = "fake"
+
+	headerAPIKey        = "X-API-KEY"
+	headerAuthorization = "Authorization"
+	bearerPrefix        = "Bearer "
+)
+
+var (
+	ErrUnknownOperation = errors.New("unknown operation")
+	ErrUnknownField     = errors.New("unknown root field")
+)
+
+type Server struct {
+	*httptest.Server
+
+	mutex sync.Mutex
+	store *store
+}
+
+type graphqlRequest struct {
+	Query         string         `json:"query"`
+	Variables     map[string]any `json:"variables"`
+	OperationName string         `json:"operationName"`
+}
+
+type graphqlError struct {
+	Message    string         `json:"message"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+type graphqlResponse struct {
+	Data   any            `json:"data"`
+	Errors []graphqlError `json:"errors,omitempty"`
+}
+
+// NewServer starts a new fake Soc2bd server, it should be closed by the caller.
+func NewServer() *Server {
+	srv := &Server{
+		store: newStore(),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(GraphqlPath, srv.authorized(srv.handleGraphql))
+	mux.HandleFunc(ValidateTokensPath, srv.authorized(srv.handleValidateTokens))
+
+	srv.Server = httptest.NewServer(mux)
+
+	return srv
+}
+
+// Setenv points the provider environment variables to the fake server.
+func (srv *Server) Setenv() error {
+	envs := map[string]string{
+		soc2bd.EnvURL:      srv.URL,
+		soc2bd.EnvNetwork:  Network,
+		soc2bd.EnvAPIToken: APIToken,
+	}
+
+	for key, val := range envs {
+		if err := os.Setenv(key, val); err != nil {
+			return fmt.Errorf("failed to set %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// AddSecurityPolicy registers a security policy without going through the API, like the policies a network comes with.
+func (srv *Server) AddSecurityPolicy(name string) string {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	return srv.store.addSecurityPolicy(name).id
+}
+
+// ConnectorHeartbeat marks the connector alive, as if it was deployed and reported to the API.
+func (srv *Server) ConnectorHeartbeat(connectorID, version, hostname, publicIP string, privateIPs ...string) {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	if conn := srv.store.connector(connectorID); conn != nil {
+		conn.state = model.ConnectorStateAlive
+		conn.lastHeartbeatAt = time.Now().UTC().Format(time.RFC3339)
+		conn.version = version
+		conn.hostname = hostname
+		conn.publicIP = publicIP
+		conn.privateIPs = privateIPs
+	}
+}
+
+// AddSyncedUser registers a user provisioned by an identity provider.
+func (srv *Server) AddSyncedUser(email, firstName, lastName string) string {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	usr := &user{
+		id:        srv.store.nextID(typeUser),
+		firstName: firstName,
+		lastName:  lastName,
+		email:     email,
+		role:      model.UserRoleMember,
+		userType:  model.UserTypeSynced,
+		state:     model.UserStateActive,
+	}
+	srv.store.users = append(srv.store.users, usr)
+
+	return usr.id
+}
+
+func (srv *Server) authorized(next http.HandlerFunc) http.HandlerFunc {
+	return func(writer http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		if req.Header.Get(headerAPIKey) != APIToken {
+			http.Error(writer, "invalid api token", http.StatusUnauthorized)
+
+			return
+		}
+
+		next(writer, req)
+	}
+}
+
+func (srv *Server) handleValidateTokens(writer http.ResponseWriter, req *http.Request) {
+	var payload struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+
+	if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	accessToken := strings.TrimPrefix(req.Header.Get(headerAuthorization), bearerPrefix)
+
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	if !srv.store.validConnectorTokens(accessToken, payload.RefreshToken) {
+		http.Error(writer, "invalid connector tokens", http.StatusUnauthorized)
+
+		return
+	}
+
+	writeJSON(writer, map[string]bool{"ok": true})
+}
+
+func (srv *Server) handleGraphql(writer http.ResponseWriter, req *http.Request) {
+	var request graphqlRequest
+	if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	data, err := srv.execute(request)
+	if err != nil {
+		writeJSON(writer, graphqlResponse{
+			Errors: []graphqlError{{
+				Message:    err.Error(),
+				Extensions: map[string]any{"code": "bad_request"},
+			}},
+		})
+
+		return
+	}
+
+	writeJSON(writer, graphqlResponse{Data: data})
+}
+
+func (srv *Server) execute(request graphqlRequest) (map[string]any, error) {
+	doc, err := parseDocument(request.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	var roots map[string]rootResolver
+
+	switch doc.operation {
+	case operationQuery:
+		roots = srv.store.queries()
+	case operationMutation:
+		roots = srv.store.mutations()
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownOperation, doc.operation)
+	}
+
+	data := make(map[string]any, len(doc.fields))
+
+	for _, root := range doc.fields {
+		resolve, ok := roots[root.name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownField, root.name)
+		}
+
+		data[root.responseKey()] = project(resolve(root.args(request.Variables)), root, request.Variables)
+	}
+
+	return data, nil
+}
+
+func writeJSON(writer http.ResponseWriter, payload any) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(writer).Encode(payload); err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+	}
+}
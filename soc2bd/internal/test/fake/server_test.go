@@ -0,0 +1,611 @@
+package fake_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
+	tfresource "github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider/resource"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test/fake"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/utils"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeClient(t *testing.T) *client.Client {
+	t.Helper()
+
+	_, c := newFakeServerClient(t)
+
+	return c
+}
+
+func newFakeServerClient(t *testing.T, opts ...client.Option) (*fake.Server, *client.Client) {
+	t.Helper()
+
+	srv := fake.NewServer()
+	t.Cleanup(srv.Close)
+
+	return srv, client.NewClient(append([]client.Option{
+		client.WithURL(srv.URL),
+		client.WithAPIToken(fake.APIToken),
+		client.WithNetwork(fake.Network),
+		client.WithHTTPTimeout(time.Second),
+		client.WithHTTPMaxRetry(0),
+	}, opts...)...)
+}
+
+func TestFakeServerRemoteNetworkCRUD(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Fake Server Remote Network CRUD", func(t *testing.T) {
+		c := newFakeClient(t)
+		ctx := context.Background()
+
+		network, err := c.CreateRemoteNetwork(ctx, &model.RemoteNetwork{Name: "aws-prod", Location: model.LocationAWS})
+		require.NoError(t, err)
+		assert.Equal(t, "aws-prod", network.Name)
+		assert.Equal(t, model.LocationAWS, network.Location)
+
+		network.Name = "aws-prod-renamed"
+		updated, err := c.UpdateRemoteNetwork(ctx, network)
+		require.NoError(t, err)
+		assert.Equal(t, "aws-prod-renamed", updated.Name)
+
+		byName, err := c.ReadRemoteNetworkByName(ctx, "aws-prod-renamed")
+		require.NoError(t, err)
+		assert.Equal(t, network.ID, byName.ID)
+
+		require.NoError(t, c.DeleteRemoteNetwork(ctx, network.ID))
+
+		_, err = c.ReadRemoteNetworkByID(ctx, network.ID)
+		assert.True(t, errors.Is(err, client.ErrGraphqlResultIsEmpty))
+
+		err = c.DeleteRemoteNetwork(ctx, network.ID)
+		assert.ErrorContains(t, err, "not found")
+	})
+}
+
+func TestFakeServerRemoteNetworkDeletionProtection(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Fake Server Non Empty Remote Network Deletion Protection", func(t *testing.T) {
+		c := newFakeClient(t)
+		meta := &provider.Meta{Client: c, DeletionProtection: model.DeletionProtectionNonEmptyRemoteNetworks}
+		ctx := context.Background()
+
+		network, err := c.CreateRemoteNetwork(ctx, &model.RemoteNetwork{Name: "network"})
+		require.NoError(t, err)
+
+		res, err := c.CreateResource(ctx, &model.Resource{Name: "db", Address: "10.0.0.1", RemoteNetworkID: network.ID, Protocols: model.DefaultProtocols()})
+		require.NoError(t, err)
+
+		connector, err := c.CreateConnector(ctx, &model.Connector{NetworkID: network.ID, Name: "connector"})
+		require.NoError(t, err)
+
+		remoteNetwork := tfresource.RemoteNetwork()
+		resourceData := schema.TestResourceDataRaw(t, remoteNetwork.Schema, map[string]interface{}{attr.Name: "network"})
+		resourceData.SetId(network.ID)
+
+		diags := remoteNetwork.DeleteContext(ctx, resourceData, meta)
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Summary, "it still has 1 Resources and 1 Connectors")
+
+		require.NoError(t, c.DeleteResource(ctx, res.ID))
+		require.NoError(t, c.DeleteConnector(ctx, connector.ID))
+
+		assert.False(t, remoteNetwork.DeleteContext(ctx, resourceData, meta).HasError())
+
+		_, err = c.ReadRemoteNetworkByID(ctx, network.ID)
+		assert.True(t, errors.Is(err, client.ErrGraphqlResultIsEmpty))
+	})
+}
+
+func TestFakeServerResourceWithGroupsAndServiceAccounts(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Fake Server Resource With Groups And Service Accounts", func(t *testing.T) {
+		c := newFakeClient(t)
+		ctx := context.Background()
+
+		network, err := c.CreateRemoteNetwork(ctx, &model.RemoteNetwork{Name: "network"})
+		require.NoError(t, err)
+
+		group, err := c.CreateGroup(ctx, &model.Group{Name: "devs"})
+		require.NoError(t, err)
+		assert.NotEmpty(t, group.SecurityPolicyID)
+
+		account, err := c.CreateServiceAccount(ctx, "ci")
+		require.NoError(t, err)
+
+		resource, err := c.CreateResource(ctx, &model.Resource{
+			Name:            "prod-db",
+			Address:         "10.0.0.1",
+			RemoteNetworkID: network.ID,
+			Groups:          []string{group.ID},
+			Protocols:       model.DefaultProtocols(),
+		})
+		require.NoError(t, err)
+
+		resource.ServiceAccounts = []string{account.ID}
+		require.NoError(t, c.AddResourceServiceAccountIDs(ctx, resource))
+
+		actual, err := c.ReadResource(ctx, resource.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{group.ID}, actual.Groups)
+		assert.Equal(t, []string{account.ID}, actual.ServiceAccounts)
+		assert.Equal(t, "10.0.0.1", actual.Address)
+
+		require.NoError(t, c.DeleteResourceGroups(ctx, resource.ID, []string{group.ID}))
+
+		actual, err = c.ReadResource(ctx, resource.ID)
+		require.NoError(t, err)
+		assert.Empty(t, actual.Groups)
+	})
+}
+
+func TestFakeServerResourcesByFilter(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Fake Server Resources By Filter", func(t *testing.T) {
+		c := newFakeClient(t)
+		ctx := context.Background()
+
+		network, err := c.CreateRemoteNetwork(ctx, &model.RemoteNetwork{Name: "network"})
+		require.NoError(t, err)
+
+		other, err := c.CreateRemoteNetwork(ctx, &model.RemoteNetwork{Name: "other"})
+		require.NoError(t, err)
+
+		group, err := c.CreateGroup(ctx, &model.Group{Name: "devs"})
+		require.NoError(t, err)
+
+		account, err := c.CreateServiceAccount(ctx, "ci")
+		require.NoError(t, err)
+
+		create := func(name, address, networkID string, groups []string, protocols *model.Protocols) *model.Resource {
+			resource, err := c.CreateResource(ctx, &model.Resource{
+				Name:            name,
+				Address:         address,
+				RemoteNetworkID: networkID,
+				Groups:          groups,
+				Protocols:       protocols,
+			})
+			require.NoError(t, err)
+
+			return resource
+		}
+
+		webProd := create("web-prod", "10.0.1.10", network.ID, []string{group.ID}, model.DefaultProtocols())
+		webDev := create("web-dev", "10.0.2.0/24", network.ID, nil, &model.Protocols{
+			TCP: &model.Protocol{Policy: model.PolicyRestricted, Ports: []*model.PortRange{{Start: 443, End: 443}}},
+			UDP: &model.Protocol{Policy: model.PolicyRestricted},
+		})
+		dbProd := create("db-prod", "db.internal.example.com", other.ID, []string{group.ID}, model.DefaultProtocols())
+
+		webDev.ServiceAccounts = []string{account.ID}
+		require.NoError(t, c.AddResourceServiceAccountIDs(ctx, webDev))
+
+		names := func(filter *model.ResourcesFilter) []string {
+			resources, err := c.ReadResourcesByFilter(ctx, filter)
+			if err != nil {
+				require.ErrorIs(t, err, client.ErrGraphqlResultIsEmpty)
+			}
+
+			return utils.Map(resources, func(resource *model.Resource) string {
+				return resource.Name
+			})
+		}
+
+		str := func(val string) *string {
+			return &val
+		}
+
+		assert.Equal(t, []string{webProd.Name, webDev.Name, dbProd.Name}, names(nil))
+		assert.Equal(t, []string{webProd.Name, webDev.Name}, names(&model.ResourcesFilter{NamePrefix: str("web-")}))
+		assert.Equal(t, []string{webProd.Name, dbProd.Name}, names(&model.ResourcesFilter{NameSuffix: str("-prod")}))
+		assert.Equal(t, []string{dbProd.Name}, names(&model.ResourcesFilter{NameRegexp: str("^db-.*")}))
+		assert.Equal(t, []string{dbProd.Name}, names(&model.ResourcesFilter{RemoteNetworkID: &other.ID}))
+		assert.Equal(t, []string{dbProd.Name}, names(&model.ResourcesFilter{AddressContains: str("example.com")}))
+		assert.Equal(t, []string{webProd.Name, webDev.Name}, names(&model.ResourcesFilter{AddressWithinCIDR: str("10.0.0.0/16")}))
+		assert.Equal(t, []string{webDev.Name}, names(&model.ResourcesFilter{ProtocolPolicy: str(model.PolicyDenyAll)}))
+		assert.Equal(t, []string{webProd.Name, dbProd.Name}, names(&model.ResourcesFilter{GroupID: &group.ID}))
+		assert.Equal(t, []string{webDev.Name}, names(&model.ResourcesFilter{ServiceAccountID: &account.ID}))
+		assert.Empty(t, names(&model.ResourcesFilter{NamePrefix: str("web-"), GroupID: &group.ID, RemoteNetworkID: &other.ID}))
+	})
+}
+
+func TestFakeServerUsersByFilter(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Fake Server Users By Filter", func(t *testing.T) {
+		t.Setenv(client.EnvPageLimit, "1")
+
+		c := newFakeClient(t)
+		ctx := context.Background()
+
+		create := func(email, firstName, role string) *model.User {
+			user, err := c.CreateUser(ctx, &model.User{Email: email, FirstName: firstName, Role: role})
+			require.NoError(t, err)
+
+			return user
+		}
+
+		alice := create("alice@corp.com", "Alice", model.UserRoleDevops)
+		bob := create("bob@corp.com", "Bob", model.UserRoleMember)
+		carol := create("carol@other.com", "Carol", model.UserRoleDevops)
+
+		groups := make([]string, 0, 2)
+
+		for _, name := range []string{"devs", "ops"} {
+			group, err := c.CreateGroup(ctx, &model.Group{Name: name, Users: []string{alice.ID}})
+			require.NoError(t, err)
+
+			groups = append(groups, group.ID)
+		}
+
+		read := func(filter *model.UsersFilter) []*model.User {
+			users, err := c.ReadUsersByFilter(ctx, filter)
+			if err != nil {
+				require.ErrorIs(t, err, client.ErrGraphqlResultIsEmpty)
+			}
+
+			return users
+		}
+
+		emails := func(filter *model.UsersFilter) []string {
+			return utils.Map(read(filter), func(user *model.User) string {
+				return user.Email
+			})
+		}
+
+		str := func(val string) *string {
+			return &val
+		}
+
+		users := read(nil)
+		require.Len(t, users, 3)
+		assert.Equal(t, groups, users[0].Groups)
+		assert.Empty(t, users[1].Groups)
+
+		assert.Equal(t, []string{alice.Email, carol.Email}, emails(&model.UsersFilter{Role: str(model.UserRoleDevops)}))
+		assert.Equal(t, []string{alice.Email, bob.Email}, emails(&model.UsersFilter{EmailDomain: str("corp.com")}))
+		assert.Equal(t, []string{bob.Email}, emails(&model.UsersFilter{EmailRegexp: str("^b.*")}))
+		assert.Equal(t, []string{carol.Email}, emails(&model.UsersFilter{FirstName: str("Carol")}))
+		assert.Equal(t, []string{alice.Email}, emails(&model.UsersFilter{Role: str(model.UserRoleDevops), EmailDomain: str("corp.com")}))
+		assert.Empty(t, emails(&model.UsersFilter{Role: str(model.UserRoleAdmin)}))
+	})
+}
+
+func TestFakeServerGroupsWithUsersAndResources(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Fake Server Groups With Users And Resources", func(t *testing.T) {
+		t.Setenv(client.EnvPageLimit, "1")
+
+		c := newFakeClient(t)
+		ctx := context.Background()
+
+		users := make([]string, 0, 3)
+
+		for _, email := range []string{"a@corp.com", "b@corp.com", "c@corp.com"} {
+			user, err := c.CreateUser(ctx, &model.User{Email: email, Role: model.UserRoleMember})
+			require.NoError(t, err)
+
+			users = append(users, user.ID)
+		}
+
+		devs, err := c.CreateGroup(ctx, &model.Group{Name: "devs", Users: users})
+		require.NoError(t, err)
+
+		ops, err := c.CreateGroup(ctx, &model.Group{Name: "ops", Users: users[:1]})
+		require.NoError(t, err)
+
+		network, err := c.CreateRemoteNetwork(ctx, &model.RemoteNetwork{Name: "network"})
+		require.NoError(t, err)
+
+		resources := make([]string, 0, 2)
+
+		for _, address := range []string{"10.0.0.1", "10.0.0.2"} {
+			resource, err := c.CreateResource(ctx, &model.Resource{
+				Name:            address,
+				Address:         address,
+				RemoteNetworkID: network.ID,
+				Groups:          []string{devs.ID},
+			})
+			require.NoError(t, err)
+
+			resources = append(resources, resource.ID)
+		}
+
+		groups, err := c.ReadGroups(ctx, nil)
+		require.NoError(t, err)
+		require.Len(t, groups, 2)
+		assert.Equal(t, users, groups[0].Users)
+		assert.Equal(t, resources, groups[0].Resources)
+		assert.Equal(t, users[:1], groups[1].Users)
+		assert.Empty(t, groups[1].Resources)
+
+		group, err := c.ReadGroup(ctx, devs.ID)
+		require.NoError(t, err)
+		assert.Equal(t, users, group.Users)
+		assert.Equal(t, resources, group.Resources)
+
+		actual, err := c.ReadGroupResources(ctx, devs.ID)
+		require.NoError(t, err)
+		assert.Equal(t, resources, actual)
+
+		shallow, err := c.ReadShallowGroups(ctx, &model.GroupsFilter{Name: &ops.Name})
+		require.NoError(t, err)
+		require.Len(t, shallow, 1)
+		assert.Equal(t, ops.ID, shallow[0].ID)
+		assert.Empty(t, shallow[0].Users)
+	})
+}
+
+func TestFakeServerAccessInventory(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Fake Server Access Inventory", func(t *testing.T) {
+		c := newFakeClient(t)
+		ctx := context.Background()
+
+		user, err := c.CreateUser(ctx, &model.User{Email: "alice@corp.com", Role: model.UserRoleMember})
+		require.NoError(t, err)
+
+		policy, err := c.CreateSecurityPolicy(ctx, &model.SecurityPolicy{Name: "strict"})
+		require.NoError(t, err)
+
+		group, err := c.CreateGroup(ctx, &model.Group{Name: "devs", Users: []string{user.ID}, SecurityPolicyID: policy.ID})
+		require.NoError(t, err)
+
+		network, err := c.CreateRemoteNetwork(ctx, &model.RemoteNetwork{Name: "network"})
+		require.NoError(t, err)
+
+		resource, err := c.CreateResource(ctx, &model.Resource{
+			Name:            "web",
+			Address:         "10.0.0.1",
+			RemoteNetworkID: network.ID,
+			Groups:          []string{group.ID},
+			Protocols:       model.DefaultProtocols(),
+		})
+		require.NoError(t, err)
+
+		account, err := c.CreateServiceAccount(ctx, "ci")
+		require.NoError(t, err)
+
+		resource.ServiceAccounts = []string{account.ID}
+		require.NoError(t, c.AddResourceServiceAccountIDs(ctx, resource))
+
+		inventory, err := c.ReadAccessInventory(ctx)
+		require.NoError(t, err)
+
+		report := model.NewAccessReport(inventory, time.Now())
+		require.Len(t, report.Rows, 2)
+
+		assert.Equal(t, model.PrincipalTypeServiceAccount, report.Rows[0].PrincipalType)
+		assert.Equal(t, resource.ID, report.Rows[0].ResourceID)
+
+		assert.Equal(t, user.Email, report.Rows[1].PrincipalName)
+		assert.Equal(t, group.Name, report.Rows[1].GroupName)
+		assert.Equal(t, policy.Name, report.Rows[1].SecurityPolicyName)
+		assert.Equal(t, resource.Address, report.Rows[1].ResourceAddress)
+		assert.Equal(t, model.PolicyAllowAll, report.Rows[1].TCP)
+	})
+}
+
+func TestFakeServerEffectiveAccess(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Fake Server Effective Access", func(t *testing.T) {
+		c := newFakeClient(t)
+		ctx := context.Background()
+
+		user, err := c.CreateUser(ctx, &model.User{Email: "alice@corp.com", Role: model.UserRoleMember})
+		require.NoError(t, err)
+
+		group, err := c.CreateGroup(ctx, &model.Group{Name: "devs", Users: []string{user.ID}})
+		require.NoError(t, err)
+
+		network, err := c.CreateRemoteNetwork(ctx, &model.RemoteNetwork{Name: "network"})
+		require.NoError(t, err)
+
+		zone, err := c.CreateResource(ctx, &model.Resource{
+			Name:            "zone",
+			Address:         "*.internal.example.com",
+			RemoteNetworkID: network.ID,
+			Groups:          []string{group.ID},
+			Protocols: &model.Protocols{
+				TCP: &model.Protocol{Policy: model.PolicyRestricted, Ports: []*model.PortRange{{Start: 5432, End: 5432}}},
+				UDP: &model.Protocol{Policy: model.PolicyRestricted},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = c.CreateResource(ctx, &model.Resource{
+			Name:            "web",
+			Address:         "10.0.0.1",
+			RemoteNetworkID: network.ID,
+			Protocols:       model.DefaultProtocols(),
+		})
+		require.NoError(t, err)
+
+		inventory, err := c.ReadAccessInventory(ctx)
+		require.NoError(t, err)
+
+		access := inventory.EffectiveAccess(model.EffectiveAccessQuery{Address: "db.internal.example.com", Protocol: model.ProtocolTCP, Port: 5432})
+		require.Len(t, access.Resources, 1)
+		assert.Equal(t, zone.ID, access.Resources[0].ID)
+		assert.Equal(t, []string{group.ID}, access.Groups)
+		assert.Equal(t, []string{user.ID}, access.Users)
+
+		access = inventory.EffectiveAccess(model.EffectiveAccessQuery{Address: "db.internal.example.com", Protocol: model.ProtocolTCP, Port: 22})
+		assert.Empty(t, access.Resources)
+		assert.Empty(t, access.Users)
+	})
+}
+
+func TestFakeServerPagination(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Fake Server Pagination", func(t *testing.T) {
+		t.Setenv(client.EnvPageLimit, "2")
+
+		c := newFakeClient(t)
+		ctx := context.Background()
+
+		users := []string{"a@corp.com", "b@corp.com", "c@corp.com", "d@corp.com", "e@corp.com"}
+		for _, email := range users {
+			_, err := c.CreateUser(ctx, &model.User{Email: email, Role: model.UserRoleMember})
+			require.NoError(t, err)
+		}
+
+		actual, err := c.ReadUsers(ctx)
+		require.NoError(t, err)
+		require.Len(t, actual, len(users))
+
+		for i, user := range actual {
+			assert.Equal(t, users[i], user.Email)
+		}
+	})
+}
+
+func TestFakeServerServiceKeyLifecycle(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Fake Server Service Key Lifecycle", func(t *testing.T) {
+		c := newFakeClient(t)
+		ctx := context.Background()
+
+		account, err := c.CreateServiceAccount(ctx, "ci")
+		require.NoError(t, err)
+
+		key, err := c.CreateServiceKey(ctx, &model.ServiceKey{Service: account.ID, Name: "key", ExpirationTime: 30})
+		require.NoError(t, err)
+		assert.NotEmpty(t, key.Token)
+		assert.Equal(t, 30, key.ExpirationTime)
+		assert.True(t, key.IsActive())
+
+		err = c.DeleteServiceKey(ctx, key.ID)
+		assert.ErrorContains(t, err, "must be revoked")
+
+		require.NoError(t, c.RevokeServiceKey(ctx, key.ID))
+		require.NoError(t, c.DeleteServiceKey(ctx, key.ID))
+	})
+}
+
+func TestFakeServerConnectorTokens(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Fake Server Connector Tokens", func(t *testing.T) {
+		c := newFakeClient(t)
+		ctx := context.Background()
+
+		network, err := c.CreateRemoteNetwork(ctx, &model.RemoteNetwork{Name: "network"})
+		require.NoError(t, err)
+
+		connector, err := c.CreateConnector(ctx, &model.Connector{NetworkID: network.ID, Name: "connector-1"})
+		require.NoError(t, err)
+
+		tokens, err := c.GenerateConnectorTokens(ctx, connector.ID)
+		require.NoError(t, err)
+
+		assert.NoError(t, c.VerifyConnectorTokens(ctx, tokens.RefreshToken, tokens.AccessToken))
+		assert.Error(t, c.VerifyConnectorTokens(ctx, "invalid", tokens.AccessToken))
+	})
+}
+
+func TestFakeServerConnectorHealth(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Fake Server Connector Health", func(t *testing.T) {
+		srv, c := newFakeServerClient(t)
+		ctx := context.Background()
+
+		network, err := c.CreateRemoteNetwork(ctx, &model.RemoteNetwork{Name: "network"})
+		require.NoError(t, err)
+
+		connectors, err := c.ReadRemoteNetworkConnectors(ctx, network.ID)
+		require.NoError(t, err)
+		assert.Empty(t, connectors)
+
+		alive, err := c.CreateConnector(ctx, &model.Connector{NetworkID: network.ID, Name: "connector-1"})
+		require.NoError(t, err)
+
+		_, err = c.CreateConnector(ctx, &model.Connector{NetworkID: network.ID, Name: "connector-2"})
+		require.NoError(t, err)
+
+		srv.ConnectorHeartbeat(alive.ID, "1.60.0", "host-1", "203.0.113.10", "10.0.0.10")
+
+		connector, err := c.ReadConnector(ctx, alive.ID)
+		require.NoError(t, err)
+		assert.Equal(t, model.ConnectorStateAlive, connector.State)
+		assert.NotEmpty(t, connector.LastHeartbeatAt)
+		assert.Equal(t, "1.60.0", connector.Version)
+		assert.Equal(t, "host-1", connector.Hostname)
+		assert.Equal(t, "203.0.113.10", connector.PublicIP)
+		assert.Equal(t, []string{"10.0.0.10"}, connector.PrivateIPs)
+
+		connectors, err = c.ReadRemoteNetworkConnectors(ctx, network.ID)
+		require.NoError(t, err)
+		assert.Len(t, connectors, 2)
+		assert.Equal(t, 1, model.CountAliveConnectors(connectors))
+	})
+}
+
+func TestFakeServerRejectsInvalidAPIToken(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Fake Server Rejects Invalid API Token", func(t *testing.T) {
+		srv := fake.NewServer()
+		defer srv.Close()
+
+		c := client.NewClient(
+			client.WithURL(srv.URL),
+			client.WithAPIToken("invalid"),
+			client.WithNetwork(fake.Network),
+			client.WithHTTPMaxRetry(0),
+		)
+
+		_, err := c.ReadUsers(context.Background())
+		assert.ErrorContains(t, err, "401")
+	})
+}
+
+func TestFakeServerSecurityPolicyCRUD(t *testing.T) {
+	t.Run("Test Soc2bd Resource : Fake Server Security Policy CRUD", func(t *testing.T) {
+		c := newFakeClient(t)
+		ctx := context.Background()
+
+		group, err := c.CreateGroup(ctx, &model.Group{Name: "admins"})
+		require.NoError(t, err)
+
+		defaultPolicyID := group.SecurityPolicyID
+
+		policy, err := c.CreateSecurityPolicy(ctx, &model.SecurityPolicy{
+			Name:                 "strict",
+			RequireMFA:           true,
+			MFAMethods:           []string{model.MFAMethodWebAuthn},
+			SessionLifetimeHours: 8,
+			DevicePosture:        &model.DevicePosture{RequireDiskEncryption: true, AllowedOperatingSystems: []string{model.OperatingSystemMacOS}},
+			Groups:               []string{group.ID},
+		})
+		require.NoError(t, err)
+
+		policy, err = c.ReadSecurityPolicyRules(ctx, policy.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "strict", policy.Name)
+		assert.True(t, policy.RequireMFA)
+		assert.Equal(t, []string{model.MFAMethodWebAuthn}, policy.MFAMethods)
+		assert.Equal(t, 8, policy.SessionLifetimeHours)
+		assert.Equal(t, &model.DevicePosture{RequireDiskEncryption: true, AllowedOperatingSystems: []string{model.OperatingSystemMacOS}}, policy.DevicePosture)
+		assert.Equal(t, []string{group.ID}, policy.Groups)
+
+		group, err = c.ReadGroup(ctx, group.ID)
+		require.NoError(t, err)
+		assert.Equal(t, policy.ID, group.SecurityPolicyID)
+
+		policy.RequireMFA = false
+		policy.DevicePosture = nil
+		policy.Groups = nil
+		updated, err := c.UpdateSecurityPolicy(ctx, policy)
+		require.NoError(t, err)
+		assert.False(t, updated.RequireMFA)
+		assert.Nil(t, updated.DevicePosture)
+		assert.Equal(t, []string{group.ID}, updated.Groups)
+
+		require.NoError(t, c.DeleteSecurityPolicyGroups(ctx, policy.ID, []string{group.ID}))
+
+		group, err = c.ReadGroup(ctx, group.ID)
+		require.NoError(t, err)
+		assert.Equal(t, defaultPolicyID, group.SecurityPolicyID)
+
+		require.NoError(t, c.DeleteSecurityPolicy(ctx, policy.ID))
+
+		_, err = c.ReadSecurityPolicyRules(ctx, policy.ID)
+		assert.True(t, errors.Is(err, client.ErrGraphqlResultIsEmpty))
+
+		err = c.DeleteSecurityPolicy(ctx, defaultPolicyID)
+		assert.ErrorContains(t, err, "can't be deleted")
+	})
+}
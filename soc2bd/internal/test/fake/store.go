@@ -0,0 +1,434 @@
+package fake
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/go-uuid"
+)
+
+const (
+	typeRemoteNetwork  = "RemoteNetwork"
+	typeConnector      = "Connector"
+	typeGroup          = "Group"
+	typeResource       = "Resource"
+	typeServiceAccount = "ServiceAccount"
+	typeServiceKey     = "ServiceAccountKey"
+	typeUser           = "User"
+	typeSecurityPolicy = "SecurityPolicy"
+
+	DefaultSecurityPolicy = "Default Policy"
+
+	hoursInDay = 24
+)
+
+type remoteNetwork struct {
+	id       string
+	name     string
+	location string
+	isActive bool
+}
+
+type connector struct {
+	id                            string
+	name                          string
+	remoteNetworkID               string
+	hasStatusNotificationsEnabled bool
+	accessToken                   string
+	refreshToken                  string
+	state                         string
+	lastHeartbeatAt               string
+	version                       string
+	hostname                      string
+	publicIP                      string
+	privateIPs                    []string
+}
+
+type group struct {
+	id               string
+	name             string
+	groupType        string
+	isActive         bool
+	users            []string
+	securityPolicyID string
+}
+
+type resource struct {
+	id                       string
+	name                     string
+	address                  string
+	remoteNetworkID          string
+	protocols                map[string]any
+	isActive                 bool
+	isVisible                bool
+	isBrowserShortcutEnabled bool
+	alias                    string
+	groups                   []string
+	serviceAccounts          []string
+}
+
+type serviceAccount struct {
+	id   string
+	name string
+}
+
+type serviceKey struct {
+	id               string
+	name             string
+	serviceAccountID string
+	status           string
+	expiresAt        string
+}
+
+type user struct {
+	id        string
+	firstName string
+	lastName  string
+	email     string
+	role      string
+	userType  string
+	state     string
+}
+
+type securityPolicy struct {
+	id                   string
+	name                 string
+	requireMFA           bool
+	mfaMethods           []string
+	sessionLifetimeHours int
+	devicePosture        map[string]any
+}
+
+// store keeps the state of the fake Soc2bd network. Every entity list
+// preserves creation order, so pagination is deterministic.
+type store struct {
+	sequence         int
+	remoteNetworks   []*remoteNetwork
+	connectors       []*connector
+	groups           []*group
+	resources        []*resource
+	serviceAccounts  []*serviceAccount
+	serviceKeys      []*serviceKey
+	users            []*user
+	securityPolicies []*securityPolicy
+}
+
+func newStore() *store {
+	s := &store{}
+	s.addSecurityPolicy(DefaultSecurityPolicy)
+
+	return s
+}
+
+func (s *store) nextID(typeName string) string {
+	s.sequence++
+
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%d", typeName, s.sequence)))
+}
+
+func (s *store) addSecurityPolicy(name string) *securityPolicy {
+	policy := &securityPolicy{id: s.nextID(typeSecurityPolicy), name: name}
+	s.securityPolicies = append(s.securityPolicies, policy)
+
+	return policy
+}
+
+func (s *store) defaultSecurityPolicyID() string {
+	for _, policy := range s.securityPolicies {
+		if policy.name == DefaultSecurityPolicy {
+			return policy.id
+		}
+	}
+
+	return ""
+}
+
+func findByID[T any](items []*T, id string, getID func(*T) string) *T {
+	for _, item := range items {
+		if getID(item) == id {
+			return item
+		}
+	}
+
+	return nil
+}
+
+func removeByID[T any](items []*T, id string, getID func(*T) string) ([]*T, bool) {
+	for i, item := range items {
+		if getID(item) == id {
+			return append(items[:i], items[i+1:]...), true
+		}
+	}
+
+	return items, false
+}
+
+func (s *store) remoteNetwork(id string) *remoteNetwork {
+	return findByID(s.remoteNetworks, id, func(item *remoteNetwork) string { return item.id })
+}
+
+func (s *store) connector(id string) *connector {
+	return findByID(s.connectors, id, func(item *connector) string { return item.id })
+}
+
+func (s *store) group(id string) *group {
+	return findByID(s.groups, id, func(item *group) string { return item.id })
+}
+
+func (s *store) resource(id string) *resource {
+	return findByID(s.resources, id, func(item *resource) string { return item.id })
+}
+
+func (s *store) serviceAccount(id string) *serviceAccount {
+	return findByID(s.serviceAccounts, id, func(item *serviceAccount) string { return item.id })
+}
+
+func (s *store) serviceKey(id string) *serviceKey {
+	return findByID(s.serviceKeys, id, func(item *serviceKey) string { return item.id })
+}
+
+func (s *store) user(id string) *user {
+	return findByID(s.users, id, func(item *user) string { return item.id })
+}
+
+func (s *store) securityPolicy(id string) *securityPolicy {
+	return findByID(s.securityPolicies, id, func(item *securityPolicy) string { return item.id })
+}
+
+func (s *store) securityPolicyByName(name string) *securityPolicy {
+	return findByID(s.securityPolicies, name, func(item *securityPolicy) string { return item.name })
+}
+
+func (s *store) deleteRemoteNetwork(id string) bool {
+	var ok bool
+
+	s.remoteNetworks, ok = removeByID(s.remoteNetworks, id, func(item *remoteNetwork) string { return item.id })
+	if !ok {
+		return false
+	}
+
+	for _, conn := range s.connectorsByNetwork(id) {
+		s.deleteConnector(conn.id)
+	}
+
+	for _, res := range s.resourcesByNetwork(id) {
+		s.deleteResource(res.id)
+	}
+
+	return true
+}
+
+func (s *store) deleteConnector(id string) bool {
+	var ok bool
+
+	s.connectors, ok = removeByID(s.connectors, id, func(item *connector) string { return item.id })
+
+	return ok
+}
+
+func (s *store) deleteGroup(id string) bool {
+	var ok bool
+
+	s.groups, ok = removeByID(s.groups, id, func(item *group) string { return item.id })
+	if !ok {
+		return false
+	}
+
+	for _, res := range s.resources {
+		res.groups = without(res.groups, id)
+	}
+
+	return true
+}
+
+func (s *store) deleteResource(id string) bool {
+	var ok bool
+
+	s.resources, ok = removeByID(s.resources, id, func(item *resource) string { return item.id })
+
+	return ok
+}
+
+func (s *store) deleteServiceAccount(id string) bool {
+	var ok bool
+
+	s.serviceAccounts, ok = removeByID(s.serviceAccounts, id, func(item *serviceAccount) string { return item.id })
+	if !ok {
+		return false
+	}
+
+	for _, key := range s.serviceKeysByAccount(id) {
+		s.deleteServiceKey(key.id)
+	}
+
+	for _, res := range s.resources {
+		res.serviceAccounts = without(res.serviceAccounts, id)
+	}
+
+	return true
+}
+
+func (s *store) deleteServiceKey(id string) bool {
+	var ok bool
+
+	s.serviceKeys, ok = removeByID(s.serviceKeys, id, func(item *serviceKey) string { return item.id })
+
+	return ok
+}
+
+func (s *store) deleteSecurityPolicy(id string) bool {
+	var ok bool
+
+	s.securityPolicies, ok = removeByID(s.securityPolicies, id, func(item *securityPolicy) string { return item.id })
+	if !ok {
+		return false
+	}
+
+	// the groups fall back to the default policy
+	for _, grp := range s.groupsBySecurityPolicy(id) {
+		grp.securityPolicyID = s.defaultSecurityPolicyID()
+	}
+
+	return true
+}
+
+func (s *store) deleteUser(id string) bool {
+	var ok bool
+
+	s.users, ok = removeByID(s.users, id, func(item *user) string { return item.id })
+	if !ok {
+		return false
+	}
+
+	for _, grp := range s.groups {
+		grp.users = without(grp.users, id)
+	}
+
+	return true
+}
+
+func (s *store) connectorsByNetwork(networkID string) []*connector {
+	var res []*connector
+
+	for _, conn := range s.connectors {
+		if conn.remoteNetworkID == networkID {
+			res = append(res, conn)
+		}
+	}
+
+	return res
+}
+
+func (s *store) resourcesByNetwork(networkID string) []*resource {
+	var res []*resource
+
+	for _, item := range s.resources {
+		if item.remoteNetworkID == networkID {
+			res = append(res, item)
+		}
+	}
+
+	return res
+}
+
+func (s *store) resourcesByServiceAccount(accountID string) []*resource {
+	var res []*resource
+
+	for _, item := range s.resources {
+		if contains(item.serviceAccounts, accountID) {
+			res = append(res, item)
+		}
+	}
+
+	return res
+}
+
+func (s *store) groupsBySecurityPolicy(policyID string) []*group {
+	var res []*group
+
+	for _, grp := range s.groups {
+		if grp.securityPolicyID == policyID {
+			res = append(res, grp)
+		}
+	}
+
+	return res
+}
+
+func (s *store) serviceKeysByAccount(accountID string) []*serviceKey {
+	var res []*serviceKey
+
+	for _, key := range s.serviceKeys {
+		if key.serviceAccountID == accountID {
+			res = append(res, key)
+		}
+	}
+
+	return res
+}
+
+func (s *store) generateConnectorTokens(conn *connector) {
+	conn.accessToken, _ = uuid.GenerateUUID()
+	conn.refreshToken, _ = uuid.GenerateUUID()
+}
+
+func (s *store) validConnectorTokens(accessToken, refreshToken string) bool {
+	for _, conn := range s.connectors {
+		if conn.accessToken != "" && conn.accessToken == accessToken && conn.refreshToken == refreshToken {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newServiceKey(id, name, accountID string, expirationDays int) *serviceKey {
+	key := &serviceKey{
+		id:               id,
+		name:             name,
+		serviceAccountID: accountID,
+		status:           model.StatusActive,
+	}
+
+	if expirationDays > 0 {
+		key.expiresAt = time.Now().Add(time.Duration(expirationDays) * hoursInDay * time.Hour).UTC().Format(time.RFC3339)
+	}
+
+	return key
+}
+
+func contains(items []string, item string) bool {
+	for _, val := range items {
+		if val == item {
+			return true
+		}
+	}
+
+	return false
+}
+
+func without(items []string, remove ...string) []string {
+	res := make([]string, 0, len(items))
+
+	for _, item := range items {
+		if !contains(remove, item) {
+			res = append(res, item)
+		}
+	}
+
+	return res
+}
+
+func union(items []string, add ...string) []string {
+	res := append([]string{}, items...)
+
+	for _, item := range add {
+		if !contains(res, item) {
+			res = append(res, item)
+		}
+	}
+
+	return res
+}
@@ -5,7 +5,7 @@ import (
 	"os"
 	"strings"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 )
 
@@ -0,0 +1,150 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAccessInventory() *model.AccessInventory {
+	return &model.AccessInventory{
+		Users: []*model.User{
+			{ID: "user-2", Email: "bob@corp.com"},
+			{ID: "user-1", Email: "alice@corp.com"},
+			{ID: "user-3", Email: "carol@corp.com"},
+		},
+		Groups: []*model.Group{
+			{ID: "group-1", Name: "devs", IsActive: true, Users: []string{"user-1", "user-2"}, Resources: []string{"resource-2", "resource-1"}, SecurityPolicyID: "policy-1"},
+			{ID: "group-2", Name: "empty", IsActive: true, Users: []string{"user-2"}, Resources: []string{"resource-3"}},
+			{ID: "group-3", Name: "disabled", IsActive: false, Users: []string{"user-3"}, Resources: []string{"resource-1"}},
+		},
+		Resources: []*model.Resource{
+			{ID: "resource-1", Name: "db", Address: "db.corp.com", IsActive: true, Protocols: &model.Protocols{
+				TCP:       &model.Protocol{Policy: model.PolicyRestricted, Ports: []*model.PortRange{{Start: 5432, End: 5432}, {Start: 8000, End: 8080}}},
+				UDP:       &model.Protocol{Policy: model.PolicyRestricted},
+				AllowIcmp: false,
+			}},
+			{ID: "resource-2", Name: "web", Address: "10.0.0.1", IsActive: true, Protocols: model.DefaultProtocols()},
+			{ID: "resource-3", Name: "old", Address: "old.corp.com", IsActive: false},
+		},
+		ServiceAccounts: []*model.ServiceAccount{
+			{ID: "account-1", Name: "ci", Resources: []string{"resource-2", "resource-3"}},
+		},
+		SecurityPolicies: []*model.SecurityPolicy{
+			{ID: "policy-1", Name: "strict"},
+		},
+	}
+}
+
+func TestAccessReportRows(t *testing.T) {
+	report := model.NewAccessReport(newAccessInventory(), time.Date(2026, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600)))
+
+	expected := []model.AccessReportRow{
+		{
+			PrincipalType: model.PrincipalTypeServiceAccount, PrincipalID: "account-1", PrincipalName: "ci",
+			ResourceID: "resource-2", ResourceName: "web", ResourceAddress: "10.0.0.1", TCP: model.PolicyAllowAll, UDP: model.PolicyAllowAll, AllowIcmp: true,
+		},
+		{
+			PrincipalType: model.PrincipalTypeUser, PrincipalID: "user-1", PrincipalName: "alice@corp.com",
+			GroupID: "group-1", GroupName: "devs", SecurityPolicyID: "policy-1", SecurityPolicyName: "strict",
+			ResourceID: "resource-1", ResourceName: "db", ResourceAddress: "db.corp.com", TCP: "RESTRICTED:5432,8000-8080", UDP: model.PolicyDenyAll,
+		},
+		{
+			PrincipalType: model.PrincipalTypeUser, PrincipalID: "user-1", PrincipalName: "alice@corp.com",
+			GroupID: "group-1", GroupName: "devs", SecurityPolicyID: "policy-1", SecurityPolicyName: "strict",
+			ResourceID: "resource-2", ResourceName: "web", ResourceAddress: "10.0.0.1", TCP: model.PolicyAllowAll, UDP: model.PolicyAllowAll, AllowIcmp: true,
+		},
+		{
+			PrincipalType: model.PrincipalTypeUser, PrincipalID: "user-2", PrincipalName: "bob@corp.com",
+			GroupID: "group-1", GroupName: "devs", SecurityPolicyID: "policy-1", SecurityPolicyName: "strict",
+			ResourceID: "resource-1", ResourceName: "db", ResourceAddress: "db.corp.com", TCP: "RESTRICTED:5432,8000-8080", UDP: model.PolicyDenyAll,
+		},
+		{
+			PrincipalType: model.PrincipalTypeUser, PrincipalID: "user-2", PrincipalName: "bob@corp.com",
+			GroupID: "group-1", GroupName: "devs", SecurityPolicyID: "policy-1", SecurityPolicyName: "strict",
+			ResourceID: "resource-2", ResourceName: "web", ResourceAddress: "10.0.0.1", TCP: model.PolicyAllowAll, UDP: model.PolicyAllowAll, AllowIcmp: true,
+		},
+		{
+			PrincipalType: model.PrincipalTypeUser, PrincipalID: "user-2", PrincipalName: "bob@corp.com",
+			GroupID: "group-2", GroupName: "empty",
+		},
+		{
+			PrincipalType: model.PrincipalTypeUser, PrincipalID: "user-3", PrincipalName: "carol@corp.com",
+		},
+	}
+
+	assert.Equal(t, expected, report.Rows)
+	assert.Equal(t, time.UTC, report.GeneratedAt.Location())
+}
+
+func TestAccessReportIsDeterministic(t *testing.T) {
+	first := model.NewAccessReport(newAccessInventory(), time.Now())
+
+	reordered := newAccessInventory()
+	reordered.Users[0], reordered.Users[2] = reordered.Users[2], reordered.Users[0]
+	reordered.Groups[0], reordered.Groups[1] = reordered.Groups[1], reordered.Groups[0]
+	second := model.NewAccessReport(reordered, time.Now().Add(time.Hour))
+
+	firstCSV, err := first.CSV()
+	require.NoError(t, err)
+
+	secondCSV, err := second.CSV()
+	require.NoError(t, err)
+
+	assert.Equal(t, firstCSV, secondCSV)
+
+	firstHash, err := first.ContentHash()
+	require.NoError(t, err)
+
+	secondHash, err := second.ContentHash()
+	require.NoError(t, err)
+
+	assert.Equal(t, firstHash, secondHash)
+	assert.Len(t, firstHash, 64)
+
+	reordered.Groups[0].Users = nil
+	changed, err := model.NewAccessReport(reordered, time.Now()).ContentHash()
+	require.NoError(t, err)
+	assert.NotEqual(t, firstHash, changed)
+}
+
+func TestAccessReportFormats(t *testing.T) {
+	inventory := &model.AccessInventory{
+		Users:  []*model.User{{ID: "user-1", Email: "alice@corp.com"}},
+		Groups: []*model.Group{{ID: "group-1", Name: "devs, ops", IsActive: true, Users: []string{"user-1"}}},
+	}
+
+	report := model.NewAccessReport(inventory, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+
+	content, err := report.CSV()
+	require.NoError(t, err)
+	assert.Equal(t, "principal_type,principal_id,principal_name,group_id,group_name,security_policy_id,security_policy_name,"+
+		"resource_id,resource_name,resource_address,tcp,udp,allow_icmp\n"+
+		"USER,user-1,alice@corp.com,group-1,\"devs, ops\",,,,,,,,false\n", content)
+
+	document, err := report.JSON()
+	require.NoError(t, err)
+
+	var actual struct {
+		GeneratedAt string                  `json:"generated_at"`
+		ContentHash string                  `json:"content_hash"`
+		Rows        []model.AccessReportRow `json:"rows"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(document), &actual))
+
+	hash, err := report.ContentHash()
+	require.NoError(t, err)
+
+	assert.Equal(t, "2026-01-02T03:04:05Z", actual.GeneratedAt)
+	assert.Equal(t, hash, actual.ContentHash)
+	assert.Equal(t, report.Rows, actual.Rows)
+
+	empty, err := model.NewAccessReport(&model.AccessInventory{}, time.Now()).JSON()
+	require.NoError(t, err)
+	assert.Contains(t, empty, `"rows":[]`)
+}
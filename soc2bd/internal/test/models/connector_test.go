@@ -5,7 +5,7 @@ import (
 	"testing"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -31,6 +31,12 @@ func TestConnectorModel(t *testing.T) {
 				attr.Name:                 "",
 				attr.RemoteNetworkID:      "",
 				attr.StatusUpdatesEnabled: false,
+				attr.State:                "",
+				attr.LastHeartbeatAt:      "",
+				attr.Version:              "",
+				attr.Hostname:             "",
+				attr.PublicIP:             "",
+				attr.PrivateIPs:           []string(nil),
 			},
 		},
 		{
@@ -39,6 +45,12 @@ func TestConnectorModel(t *testing.T) {
 				Name:                 "name",
 				NetworkID:            "network-id",
 				StatusUpdatesEnabled: &boolTrue,
+				State:                model.ConnectorStateAlive,
+				LastHeartbeatAt:      "2026-01-02T03:04:05Z",
+				Version:              "1.60.0",
+				Hostname:             "host",
+				PublicIP:             "203.0.113.10",
+				PrivateIPs:           []string{"10.0.0.10"},
 			},
 			expectedID:   "id",
 			expectedName: "name",
@@ -47,6 +59,12 @@ func TestConnectorModel(t *testing.T) {
 				attr.Name:                 "name",
 				attr.RemoteNetworkID:      "network-id",
 				attr.StatusUpdatesEnabled: true,
+				attr.State:                model.ConnectorStateAlive,
+				attr.LastHeartbeatAt:      "2026-01-02T03:04:05Z",
+				attr.Version:              "1.60.0",
+				attr.Hostname:             "host",
+				attr.PublicIP:             "203.0.113.10",
+				attr.PrivateIPs:           []string{"10.0.0.10"},
 			},
 		},
 	}
@@ -59,3 +77,30 @@ func TestConnectorModel(t *testing.T) {
 		})
 	}
 }
+
+func TestCountAliveConnectors(t *testing.T) {
+	cases := []struct {
+		connectors []*model.Connector
+		expected   int
+	}{
+		{
+			connectors: nil,
+			expected:   0,
+		},
+		{
+			connectors: []*model.Connector{
+				{State: model.ConnectorStateAlive},
+				{State: model.ConnectorStateDeadNoHeartbeat},
+				{State: model.ConnectorStateAlive},
+				{State: model.ConnectorStateDeadNoRelays},
+			},
+			expected: 2,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, model.CountAliveConnectors(c.connectors))
+		})
+	}
+}
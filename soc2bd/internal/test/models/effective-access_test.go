@@ -0,0 +1,163 @@
+package models
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddressMatches(t *testing.T) {
+	cases := []struct {
+		resourceAddress string
+		address         string
+		expected        bool
+	}{
+		{resourceAddress: "10.0.0.1", address: "10.0.0.1", expected: true},
+		{resourceAddress: "10.0.0.1", address: "10.0.0.2", expected: false},
+		{resourceAddress: "10.0.0.0/24", address: "10.0.0.42", expected: true},
+		{resourceAddress: "10.0.0.0/24", address: "10.0.1.42", expected: false},
+		{resourceAddress: "10.0.0.0/24", address: "10.0.0.128/25", expected: true},
+		{resourceAddress: "10.0.0.5", address: "10.0.0.0/16", expected: true},
+		{resourceAddress: "10.0.0.0/24", address: "10.0.0.0/16", expected: true},
+		{resourceAddress: "10.1.0.0/24", address: "10.0.0.0/16", expected: false},
+		{resourceAddress: "fd00::/64", address: "fd00::1", expected: true},
+		{resourceAddress: "fd00::/64", address: "10.0.0.1", expected: false},
+		{resourceAddress: "db.example.com", address: "DB.example.com.", expected: true},
+		{resourceAddress: "db.example.com", address: "web.example.com", expected: false},
+		{resourceAddress: "*.example.com", address: "db.example.com", expected: true},
+		{resourceAddress: "*.example.com", address: "db.prod.example.com", expected: true},
+		{resourceAddress: "*.example.com", address: "example.com", expected: false},
+		{resourceAddress: "*.example.com", address: "db.example.org", expected: false},
+		{resourceAddress: "db-?.example.com", address: "db-1.example.com", expected: true},
+		{resourceAddress: "db-?.example.com", address: "db-10.example.com", expected: false},
+		{resourceAddress: "example.com", address: "10.0.0.1", expected: false},
+		{resourceAddress: "10.0.0.1", address: "example.com", expected: false},
+		{resourceAddress: "", address: "example.com", expected: false},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, model.AddressMatches(c.resourceAddress, c.address))
+		})
+	}
+}
+
+func TestProtocolsAllows(t *testing.T) {
+	restricted := &model.Protocols{
+		TCP:       &model.Protocol{Policy: model.PolicyRestricted, Ports: []*model.PortRange{{Start: 80, End: 80}, {Start: 8000, End: 8080}}},
+		UDP:       &model.Protocol{Policy: model.PolicyRestricted},
+		AllowIcmp: false,
+	}
+
+	cases := []struct {
+		protocols *model.Protocols
+		protocol  string
+		port      int
+		expected  bool
+	}{
+		{protocols: nil, protocol: model.ProtocolTCP, port: 22, expected: true},
+		{protocols: model.DefaultProtocols(), protocol: model.ProtocolICMP, expected: true},
+		{protocols: restricted, protocol: model.ProtocolTCP, port: 80, expected: true},
+		{protocols: restricted, protocol: model.ProtocolTCP, port: 8042, expected: true},
+		{protocols: restricted, protocol: model.ProtocolTCP, port: 8081, expected: false},
+		{protocols: restricted, protocol: model.ProtocolTCP, expected: true},
+		{protocols: restricted, protocol: model.ProtocolUDP, port: 80, expected: false},
+		{protocols: restricted, protocol: model.ProtocolUDP, expected: false},
+		{protocols: restricted, protocol: model.ProtocolICMP, expected: false},
+		{protocols: restricted, port: 80, expected: true},
+		{protocols: restricted, port: 53, expected: false},
+		{protocols: restricted, expected: true},
+		{protocols: &model.Protocols{TCP: &model.Protocol{Policy: model.PolicyRestricted}, UDP: &model.Protocol{Policy: model.PolicyRestricted}, AllowIcmp: true}, expected: true},
+		{protocols: &model.Protocols{TCP: &model.Protocol{Policy: model.PolicyRestricted}, UDP: &model.Protocol{Policy: model.PolicyRestricted}, AllowIcmp: true}, port: 80, expected: false},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, c.protocols.Allows(c.protocol, c.port))
+		})
+	}
+}
+
+func TestEffectiveAccess(t *testing.T) {
+	inventory := &model.AccessInventory{
+		Groups: []*model.Group{
+			{ID: "group-1", IsActive: true, Users: []string{"user-2", "user-1"}, Resources: []string{"resource-1"}},
+			{ID: "group-2", IsActive: true, Users: []string{"user-3"}, Resources: []string{"resource-2"}},
+			{ID: "group-3", IsActive: false, Users: []string{"user-4"}, Resources: []string{"resource-1"}},
+			{ID: "group-4", IsActive: true, Users: []string{"user-1"}, Resources: []string{"resource-3"}},
+		},
+		Resources: []*model.Resource{
+			{ID: "resource-1", Address: "10.0.0.0/24", IsActive: true, Protocols: &model.Protocols{
+				TCP: &model.Protocol{Policy: model.PolicyRestricted, Ports: []*model.PortRange{{Start: 443, End: 443}}},
+				UDP: &model.Protocol{Policy: model.PolicyRestricted},
+			}},
+			{ID: "resource-2", Address: "10.0.0.5", IsActive: true, Protocols: model.DefaultProtocols()},
+			{ID: "resource-3", Address: "10.0.0.5", IsActive: false},
+		},
+		ServiceAccounts: []*model.ServiceAccount{
+			{ID: "account-1", Resources: []string{"resource-2"}},
+			{ID: "account-2", Resources: []string{"resource-3"}},
+		},
+	}
+
+	cases := []struct {
+		query     model.EffectiveAccessQuery
+		resources []string
+		expected  *model.EffectiveAccess
+	}{
+		{
+			query:     model.EffectiveAccessQuery{Address: "10.0.0.5"},
+			resources: []string{"resource-1", "resource-2"},
+			expected: &model.EffectiveAccess{
+				Groups:          []string{"group-1", "group-2"},
+				Users:           []string{"user-1", "user-2", "user-3"},
+				ServiceAccounts: []string{"account-1"},
+			},
+		},
+		{
+			query:     model.EffectiveAccessQuery{Address: "10.0.0.5", Protocol: model.ProtocolTCP, Port: 22},
+			resources: []string{"resource-2"},
+			expected: &model.EffectiveAccess{
+				Groups:          []string{"group-2"},
+				Users:           []string{"user-3"},
+				ServiceAccounts: []string{"account-1"},
+			},
+		},
+		{
+			query:     model.EffectiveAccessQuery{Address: "10.0.0.9", Protocol: model.ProtocolTCP, Port: 443},
+			resources: []string{"resource-1"},
+			expected: &model.EffectiveAccess{
+				Groups:          []string{"group-1"},
+				Users:           []string{"user-1", "user-2"},
+				ServiceAccounts: []string{},
+			},
+		},
+		{
+			query:     model.EffectiveAccessQuery{Address: "192.168.0.1"},
+			resources: nil,
+			expected: &model.EffectiveAccess{
+				Groups:          []string{},
+				Users:           []string{},
+				ServiceAccounts: []string{},
+			},
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			actual := inventory.EffectiveAccess(c.query)
+
+			var resources []string
+			for _, resource := range actual.Resources {
+				resources = append(resources, resource.ID)
+			}
+
+			assert.Equal(t, c.resources, resources)
+
+			actual.Resources = nil
+			assert.Equal(t, c.expected, actual)
+		})
+	}
+}
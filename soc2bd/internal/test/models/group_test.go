@@ -5,7 +5,7 @@ import (
 	"testing"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -25,6 +25,8 @@ func TestGroupModel(t *testing.T) {
 				attr.Type:             "",
 				attr.IsActive:         false,
 				attr.SecurityPolicyID: "",
+				attr.UserIDs:          []string(nil),
+				attr.ResourceIDs:      []string(nil),
 			},
 		},
 		{
@@ -34,6 +36,8 @@ func TestGroupModel(t *testing.T) {
 				Type:             "type",
 				IsActive:         true,
 				SecurityPolicyID: "policy-id",
+				Users:            []string{"user-1", "user-2"},
+				Resources:        []string{"resource-1"},
 			},
 			expectedID:   "id",
 			expectedName: "name",
@@ -43,6 +47,8 @@ func TestGroupModel(t *testing.T) {
 				attr.Type:             "type",
 				attr.IsActive:         true,
 				attr.SecurityPolicyID: "policy-id",
+				attr.UserIDs:          []string{"user-1", "user-2"},
+				attr.ResourceIDs:      []string{"resource-1"},
 			},
 		},
 	}
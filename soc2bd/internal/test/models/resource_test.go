@@ -6,7 +6,7 @@ import (
 	"testing"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -82,6 +82,40 @@ func TestNewPortRange(t *testing.T) {
 	}
 }
 
+func TestMergePortRanges(t *testing.T) {
+	cases := []struct {
+		input    []*model.PortRange
+		expected []*model.PortRange
+	}{
+		{
+			input:    nil,
+			expected: []*model.PortRange{},
+		},
+		{
+			input:    []*model.PortRange{{Start: 80, End: 80}, {Start: 80, End: 90}, {Start: 91, End: 91}},
+			expected: []*model.PortRange{{Start: 80, End: 91}},
+		},
+		{
+			input:    []*model.PortRange{{Start: 443, End: 443}, {Start: 80, End: 80}, {Start: 80, End: 80}},
+			expected: []*model.PortRange{{Start: 80, End: 80}, {Start: 443, End: 443}},
+		},
+		{
+			input:    []*model.PortRange{{Start: 8000, End: 8080}, {Start: 8010, End: 8020}, {Start: 82, End: 83}, {Start: 80, End: 80}},
+			expected: []*model.PortRange{{Start: 80, End: 80}, {Start: 82, End: 83}, {Start: 8000, End: 8080}},
+		},
+		{
+			input:    []*model.PortRange{{Start: 1, End: 100}, nil, {Start: 50, End: 200}, {Start: 201, End: 300}},
+			expected: []*model.PortRange{{Start: 1, End: 300}},
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, model.MergePortRanges(c.input))
+		})
+	}
+}
+
 func TestResourceModel(t *testing.T) {
 	var (
 		emptySlice       []interface{}
@@ -289,3 +323,69 @@ func TestResourceAccessToTerraform(t *testing.T) {
 		})
 	}
 }
+
+func TestAddressWithinCIDR(t *testing.T) {
+	cases := []struct {
+		address  string
+		cidr     string
+		expected bool
+	}{
+		{address: "10.0.1.10", cidr: "10.0.0.0/16", expected: true},
+		{address: "10.1.1.10", cidr: "10.0.0.0/16", expected: false},
+		{address: "10.0.2.0/24", cidr: "10.0.0.0/16", expected: true},
+		{address: "10.0.0.0/8", cidr: "10.0.0.0/16", expected: false},
+		{address: "2001:db8::1", cidr: "2001:db8::/32", expected: true},
+		{address: "internal.example.com", cidr: "10.0.0.0/8", expected: false},
+		{address: "10.0.1.10", cidr: "invalid", expected: false},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, model.AddressWithinCIDR(c.address, c.cidr))
+		})
+	}
+}
+
+func TestResourcesFilterMatchResource(t *testing.T) {
+	networkID := "network-1"
+	contains := "example"
+	cidr := "10.0.0.0/16"
+	denyAll := model.PolicyDenyAll
+	allowAll := model.PolicyAllowAll
+
+	restricted := &model.Resource{
+		RemoteNetworkID: networkID,
+		Address:         "10.0.1.10",
+		Protocols: &model.Protocols{
+			TCP: &model.Protocol{Policy: model.PolicyRestricted, Ports: []*model.PortRange{{Start: 443, End: 443}}},
+			UDP: &model.Protocol{Policy: model.PolicyRestricted},
+		},
+	}
+
+	fqdn := &model.Resource{
+		RemoteNetworkID: "network-2",
+		Address:         "db.example.com",
+	}
+
+	cases := []struct {
+		filter   *model.ResourcesFilter
+		resource *model.Resource
+		expected bool
+	}{
+		{filter: nil, resource: fqdn, expected: true},
+		{filter: &model.ResourcesFilter{RemoteNetworkID: &networkID}, resource: restricted, expected: true},
+		{filter: &model.ResourcesFilter{RemoteNetworkID: &networkID}, resource: fqdn, expected: false},
+		{filter: &model.ResourcesFilter{AddressContains: &contains}, resource: fqdn, expected: true},
+		{filter: &model.ResourcesFilter{AddressWithinCIDR: &cidr}, resource: restricted, expected: true},
+		{filter: &model.ResourcesFilter{AddressWithinCIDR: &cidr}, resource: fqdn, expected: false},
+		{filter: &model.ResourcesFilter{ProtocolPolicy: &denyAll}, resource: restricted, expected: true},
+		{filter: &model.ResourcesFilter{ProtocolPolicy: &allowAll}, resource: restricted, expected: false},
+		{filter: &model.ResourcesFilter{ProtocolPolicy: &allowAll}, resource: fqdn, expected: true},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, c.filter.MatchResource(c.resource))
+		})
+	}
+}
@@ -3,8 +3,9 @@ package models
 import (
 	"fmt"
 	"testing"
+	"time"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -39,3 +40,42 @@ func TestServiceAccountKeyModel(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceAccountKeyExpiration(t *testing.T) {
+	cases := []struct {
+		key model.ServiceKey
+
+		expectedExpired bool
+		expectedStatus  string
+	}{
+		{
+			key:            model.ServiceKey{Status: model.StatusActive},
+			expectedStatus: model.StatusActive,
+		},
+		{
+			key:            model.ServiceKey{Status: model.StatusActive, ExpiresAt: time.Now().Add(time.Hour).Format(time.RFC3339)},
+			expectedStatus: model.StatusActive,
+		},
+		{
+			key:             model.ServiceKey{Status: model.StatusActive, ExpiresAt: time.Now().Add(-time.Hour).Format(time.RFC3339)},
+			expectedExpired: true,
+			expectedStatus:  model.StatusExpired,
+		},
+		{
+			key:             model.ServiceKey{Status: model.StatusExpired},
+			expectedExpired: true,
+			expectedStatus:  model.StatusExpired,
+		},
+		{
+			key:            model.ServiceKey{Status: model.StatusRevoked},
+			expectedStatus: model.StatusRevoked,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expectedExpired, c.key.IsExpired())
+			assert.Equal(t, c.expectedStatus, c.key.GetStatus())
+		})
+	}
+}
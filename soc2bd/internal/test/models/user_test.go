@@ -5,7 +5,7 @@ import (
 	"testing"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -24,6 +24,7 @@ func TestUserModel(t *testing.T) {
 				attr.IsAdmin:   false,
 				attr.Role:      "",
 				attr.Type:      "",
+				attr.GroupIDs:  []string(nil),
 			},
 		},
 		{
@@ -34,6 +35,7 @@ func TestUserModel(t *testing.T) {
 				Email:     "john@white.com",
 				Role:      "ADMIN",
 				Type:      "MANUAL",
+				Groups:    []string{"g1", "g2"},
 			},
 			expected: map[string]interface{}{
 				attr.ID:        "1",
@@ -43,6 +45,7 @@ func TestUserModel(t *testing.T) {
 				attr.IsAdmin:   true,
 				attr.Role:      "ADMIN",
 				attr.Type:      "MANUAL",
+				attr.GroupIDs:  []string{"g1", "g2"},
 			},
 		},
 		{
@@ -62,6 +65,7 @@ func TestUserModel(t *testing.T) {
 				attr.IsAdmin:   false,
 				attr.Role:      "USER",
 				attr.Type:      "SYNCED",
+				attr.GroupIDs:  []string(nil),
 			},
 		},
 	}
@@ -0,0 +1,134 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyAddress(t *testing.T) {
+	cases := []struct {
+		address     string
+		expected    string
+		expectedErr string
+	}{
+		{address: "10.0.0.1", expected: model.AddressTypeIPv4},
+		{address: "fd00::1", expected: model.AddressTypeIPv6},
+		{address: "::ffff:10.0.0.1", expected: model.AddressTypeIPv6},
+		{address: "10.0.0.0/24", expected: model.AddressTypeCIDR},
+		{address: "fd00::/64", expected: model.AddressTypeCIDR},
+		{address: "acc-test.com", expected: model.AddressTypeFQDN},
+		{address: "acc-test.com.12", expected: model.AddressTypeFQDN},
+		{address: "db.corp.com.", expected: model.AddressTypeFQDN},
+		{address: "intranet", expected: model.AddressTypeFQDN},
+		{address: "_sip._tcp.example.com", expected: model.AddressTypeFQDN},
+		{address: "my_host.corp", expected: model.AddressTypeFQDN},
+		{address: "*.corp.com", expected: model.AddressTypeWildcard},
+		{address: "db-?.corp.com", expected: model.AddressTypeWildcard},
+		{
+			address:     "",
+			expectedErr: "address `` is invalid: it's empty",
+		},
+		{
+			address:     "10.0.0.0/33",
+			expected:    model.AddressTypeCIDR,
+			expectedErr: "address `10.0.0.0/33` is invalid: it's not a valid CIDR block",
+		},
+		{
+			address:     "10.0.0.1/24",
+			expected:    model.AddressTypeCIDR,
+			expectedErr: "address `10.0.0.1/24` is invalid: it has host bits set, the CIDR block is `10.0.0.0/24`",
+		},
+		{
+			address:     "fd00::1/64",
+			expected:    model.AddressTypeCIDR,
+			expectedErr: "address `fd00::1/64` is invalid: it has host bits set, the CIDR block is `fd00::/64`",
+		},
+		{
+			address:     "*.corp..com",
+			expected:    model.AddressTypeWildcard,
+			expectedErr: "address `*.corp..com` is invalid: it has an empty label",
+		},
+		{
+			address:     "-db.corp.com",
+			expected:    model.AddressTypeFQDN,
+			expectedErr: "address `-db.corp.com` is invalid: label `-db` starts or ends with a hyphen",
+		},
+		{
+			address:     "db#1.corp.com",
+			expected:    model.AddressTypeFQDN,
+			expectedErr: "address `db#1.corp.com` is invalid: label `db#1` has the invalid character `#`",
+		},
+		{
+			address:     "10.0.0.256",
+			expected:    model.AddressTypeFQDN,
+			expectedErr: "address `10.0.0.256` is invalid: all its labels are numeric, it's neither an IP nor a DNS name",
+		},
+		{
+			address:     strings.Repeat("a", 64) + ".com",
+			expected:    model.AddressTypeFQDN,
+			expectedErr: fmt.Sprintf("address `%s.com` is invalid: label `%s` is longer than 63 characters", strings.Repeat("a", 64), strings.Repeat("a", 64)),
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			actual, err := model.ClassifyAddress(c.address)
+
+			assert.Equal(t, c.expected, actual)
+
+			if c.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, c.expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidateAlias(t *testing.T) {
+	cases := []struct {
+		alias       string
+		expectedErr string
+	}{
+		{alias: "db.internal"},
+		{alias: "db.internal."},
+		{alias: "web-1.corp.com"},
+		{alias: "my_host.corp"},
+		{
+			alias:       "",
+			expectedErr: "alias `` is not a valid DNS name: it has an empty label",
+		},
+		{
+			alias:       "*.corp.com",
+			expectedErr: "alias `*.corp.com` is not a valid DNS name: label `*` has the invalid character `*`",
+		},
+		{
+			alias:       "db..internal",
+			expectedErr: "alias `db..internal` is not a valid DNS name: it has an empty label",
+		},
+		{
+			alias:       "db.internal-",
+			expectedErr: "alias `db.internal-` is not a valid DNS name: label `internal-` starts or ends with a hyphen",
+		},
+		{
+			alias:       strings.Repeat("a.", 127) + "com",
+			expectedErr: fmt.Sprintf("alias `%scom` is not a valid DNS name: it's longer than 253 characters", strings.Repeat("a.", 127)),
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			err := model.ValidateAlias(c.alias)
+
+			if c.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, c.expectedErr)
+			}
+		})
+	}
+}
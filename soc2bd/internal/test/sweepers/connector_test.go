@@ -4,7 +4,7 @@ import (
 	"context"
 	"errors"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -0,0 +1,33 @@
+package sweepers
+
+import (
+	"context"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const resourceSecurityPolicy = "soc2bd_security_policy"
+
+func init() {
+	resource.AddTestSweepers(resourceSecurityPolicy, &resource.Sweeper{
+		Name: resourceSecurityPolicy,
+		F: newTestSweeper(resourceSecurityPolicy,
+			func(client *client.Client, ctx context.Context) ([]Resource, error) {
+				resources, err := client.ReadSecurityPolicies(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				items := make([]Resource, 0, len(resources))
+				for _, r := range resources {
+					items = append(items, r)
+				}
+				return items, nil
+			},
+			func(client *client.Client, ctx context.Context, id string) error {
+				return client.DeleteSecurityPolicy(ctx, id)
+			},
+		),
+	})
+}
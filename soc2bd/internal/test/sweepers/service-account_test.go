@@ -4,8 +4,8 @@ import (
 	"context"
 	"errors"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider/resource"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
 	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -10,8 +10,8 @@ import (
 	"time"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/test"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -45,12 +45,13 @@ func sharedClient(tenant string) (*client.Client, error) {
 	}
 
 	return client.NewClient(
-			os.Getenv(soc2bd.EnvURL),
-			os.Getenv(soc2bd.EnvAPIToken),
-			os.Getenv(soc2bd.EnvNetwork),
-			getEnv(soc2bd.EnvHTTPTimeout, 30*time.Second),
-			2,
-			"sweeper"),
+			client.WithURL(os.Getenv(soc2bd.EnvURL)),
+			client.WithAPIToken(os.Getenv(soc2bd.EnvAPIToken)),
+			client.WithNetwork(os.Getenv(soc2bd.EnvNetwork)),
+			client.WithHTTPTimeout(getEnv(soc2bd.EnvHTTPTimeout, 30*time.Second)),
+			client.WithHTTPMaxRetry(2),
+			client.WithUserAgent("Soc2bdTF/sweeper"),
+		),
 		nil
 }
 
@@ -3,8 +3,8 @@ package sweepers
 import (
 	"context"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
 	soc2bd "github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider/resource"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
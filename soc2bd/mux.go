@@ -0,0 +1,39 @@
+package soc2bd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProviderAddress is the registry address the provider is served as.
+const ProviderAddress = "registry.terraform.io/soc2bd/soc2bd"
+
+// ProviderServerFactory serves the SDK provider, upgraded to protocol v6, alongside the plugin framework provider.
+// Each resource type is served by only one of them, so resources can be migrated to the framework one at a time.
+func ProviderServerFactory(ctx context.Context, sdkProvider *schema.Provider, version string) (func() tfprotov6.ProviderServer, error) {
+	upgradedSdkServer, err := tf5to6server.UpgradeServer(ctx, func() tfprotov5.ProviderServer {
+		return sdkProvider.GRPCProvider()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upgrade SDK provider to protocol v6: %w", err)
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx,
+		func() tfprotov6.ProviderServer {
+			return upgradedSdkServer
+		},
+		providerserver.NewProtocol6(NewFrameworkProvider(version)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mux providers: %w", err)
+	}
+
+	return muxServer.ProviderServer, nil
+}
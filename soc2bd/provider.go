@@ -2,28 +2,53 @@ package soc2bd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider/datasource"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/provider/resource"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
-	DefaultHTTPTimeout  = "10"
-	DefaultHTTPMaxRetry = "10"
-	DefaultURL          = "soc2bd.com"
+	DefaultHTTPTimeout        = "10"
+	DefaultHTTPMaxRetry       = "10"
+	DefaultHTTPRateLimit      = "0"
+	DefaultHTTPRateLimitBurst = "5"
+	DefaultURL                = "soc2bd.com"
+	DefaultDeletionProtection = model.DeletionProtectionNone
+	DefaultAPITokenCommandTTL = "300"
 
 	// EnvAPIToken env var for Token.
-	EnvAPIToken     = "SOC2BD_API_TOKEN" //#nosec
-	EnvNetwork      = "SOC2BD_NETWORK"
-	EnvURL          = "SOC2BD_URL"
-	EnvHTTPTimeout  = "SOC2BD_HTTP_TIMEOUT"
-	EnvHTTPMaxRetry = "SOC2BD_HTTP_MAX_RETRY"
+	EnvAPIToken           = "SOC2BD_API_TOKEN" //#nosec
+	EnvNetwork            = "SOC2BD_NETWORK"
+	EnvURL                = "SOC2BD_URL"
+	EnvHTTPTimeout        = "SOC2BD_HTTP_TIMEOUT"
+	EnvHTTPMaxRetry       = "SOC2BD_HTTP_MAX_RETRY"
+	EnvHTTPRateLimit      = "SOC2BD_HTTP_RATE_LIMIT"
+	EnvHTTPRateLimitBurst = "SOC2BD_HTTP_RATE_LIMIT_BURST"
+	EnvDeletionProtection = "SOC2BD_DELETION_PROTECTION"
+	EnvCACertFile         = "SOC2BD_CA_CERT_FILE"
+	EnvProxyURL           = "SOC2BD_PROXY_URL"
+	EnvNoProxy            = "SOC2BD_NO_PROXY"
+	EnvInsecureSkipVerify = "SOC2BD_INSECURE_SKIP_VERIFY"
+)
+
+// The API token sources, they take precedence over the api_token.
+const (
+	EnvAPITokenFile        = "SOC2BD_API_TOKEN_FILE"         //#nosec
+	EnvAPITokenCommand     = "SOC2BD_API_TOKEN_COMMAND"      //#nosec
+	EnvAPITokenCommandTTL  = "SOC2BD_API_TOKEN_COMMAND_TTL"  //#nosec
+	EnvAPITokenAgentSocket = "SOC2BD_API_TOKEN_AGENT_SOCKET" //#nosec
 )
 
 func Provider(version string) *schema.Provider {
@@ -34,7 +59,9 @@ func Provider(version string) *schema.Provider {
 			resource.Soc2bdConnector:         resource.Connector(),
 			resource.Soc2bdConnectorTokens:   resource.ConnectorTokens(),
 			resource.Soc2bdGroup:             resource.Group(),
-			resource.Soc2bdResource:          resource.Resource(),
+			resource.Soc2bdGroupMembership:   resource.GroupMembership(),
+			resource.Soc2bdResourceAccess:    resource.ResourceAccess(),
+			resource.Soc2bdSecurityPolicy:    resource.SecurityPolicy(),
 			resource.Soc2bdServiceAccount:    resource.ServiceAccount(),
 			resource.Soc2bdServiceAccountKey: resource.ServiceKey(),
 			resource.Soc2bdUser:              resource.User(),
@@ -53,6 +80,8 @@ func Provider(version string) *schema.Provider {
 			datasource.Soc2bdServiceAccounts:  datasource.ServiceAccounts(),
 			datasource.Soc2bdSecurityPolicy:   datasource.SecurityPolicy(),
 			datasource.Soc2bdSecurityPolicies: datasource.SecurityPolicies(),
+			datasource.Soc2bdAccessReport:     datasource.AccessReport(),
+			datasource.Soc2bdEffectiveAccess:  datasource.EffectiveAccess(),
 		},
 	}
 	provider.ConfigureContextFunc = configure(version, provider)
@@ -71,6 +100,41 @@ func providerOptions() map[string]*schema.Schema {
 				"from the Soc2bd Admin Console ([documentation](https://docs.soc2bd.com/docs/api-overview)).\n"+
 				"Alternatively, this can be specified using the %s environment variable.", EnvAPIToken),
 		},
+		attr.APITokenFile: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			DefaultFunc:   schema.EnvDefaultFunc(EnvAPITokenFile, nil),
+			ConflictsWith: []string{attr.APITokenCommand, attr.APITokenAgentSocket},
+			Description: fmt.Sprintf("Path to a file holding the API token, it's read again whenever the file changes, "+
+				"so the token can be rotated during a run. It takes precedence over the `%s`.\n"+
+				"Alternatively, this can be specified using the %s environment variable.", attr.APIToken, EnvAPITokenFile),
+		},
+		attr.APITokenCommand: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			DefaultFunc:   schema.EnvDefaultFunc(EnvAPITokenCommand, nil),
+			ConflictsWith: []string{attr.APITokenFile, attr.APITokenAgentSocket},
+			Description: fmt.Sprintf("Credential helper command printing the API token, its arguments are separated by spaces and it's run without a shell. "+
+				"The token is cached for the `%s`. It takes precedence over the `%s`.\n"+
+				"Alternatively, this can be specified using the %s environment variable.", attr.APITokenCommandTTL, attr.APIToken, EnvAPITokenCommand),
+		},
+		attr.APITokenCommandTTL: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			DefaultFunc:  schema.EnvDefaultFunc(EnvAPITokenCommandTTL, DefaultAPITokenCommandTTL),
+			ValidateFunc: validation.IntAtLeast(1),
+			Description: fmt.Sprintf("Specifies how long in seconds the token printed by the `%s` is used before running it again. The default value is %s seconds.\n"+
+				"Alternatively, this can be specified using the %s environment variable", attr.APITokenCommand, DefaultAPITokenCommandTTL, EnvAPITokenCommandTTL),
+		},
+		attr.APITokenAgentSocket: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			DefaultFunc:   schema.EnvDefaultFunc(EnvAPITokenAgentSocket, nil),
+			ConflictsWith: []string{attr.APITokenFile, attr.APITokenCommand},
+			Description: fmt.Sprintf("Path to the Unix socket of a local agent serving the API token at `GET /token` over HTTP, "+
+				"with `?refresh=true` once the API rejected the token. It takes precedence over the `%s`.\n"+
+				"Alternatively, this can be specified using the %s environment variable.", attr.APIToken, EnvAPITokenAgentSocket),
+		},
 		attr.Network: {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -103,33 +167,254 @@ func providerOptions() map[string]*schema.Schema {
 			Description: fmt.Sprintf("Specifies a retry limit for the http requests made. The default value is %s.\n"+
 				"Alternatively, this can be specified using the %s environment variable", DefaultHTTPMaxRetry, EnvHTTPMaxRetry),
 		},
+		attr.HTTPRateLimit: {
+			Type:        schema.TypeFloat,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(EnvHTTPRateLimit, DefaultHTTPRateLimit),
+			Description: fmt.Sprintf("Specifies a limit of http requests per second made to the API, requests above it wait for their turn.\n"+
+				"Throttled requests are retried after the delay the API asked for. The default value is %s, which means no limit.\n"+
+				"Alternatively, this can be specified using the %s environment variable", DefaultHTTPRateLimit, EnvHTTPRateLimit),
+		},
+		attr.HTTPRateLimitBurst: {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(EnvHTTPRateLimitBurst, DefaultHTTPRateLimitBurst),
+			Description: fmt.Sprintf("Specifies how many http requests can be made at once when the `%s` is set. The default value is %s.\n"+
+				"Alternatively, this can be specified using the %s environment variable", attr.HTTPRateLimit, DefaultHTTPRateLimitBurst, EnvHTTPRateLimitBurst),
+		},
+		attr.DeletionProtection: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			DefaultFunc:  schema.EnvDefaultFunc(EnvDeletionProtection, DefaultDeletionProtection),
+			ValidateFunc: validation.StringInSlice(model.DeletionProtectionLevels, false),
+			Description: fmt.Sprintf("The default deletion protection of the managed objects, used when their `%s` is not set: "+
+				"`%s` only protects the objects with it enabled, `%s` also prevents deleting Remote Networks that still have Resources or Connectors, "+
+				"and `%s` protects every object. The default value is %s.\n"+
+				"Alternatively, this can be specified using the %s environment variable", attr.DeletionProtection,
+				model.DeletionProtectionNone, model.DeletionProtectionNonEmptyRemoteNetworks, model.DeletionProtectionAll, DefaultDeletionProtection, EnvDeletionProtection),
+		},
+		attr.CACertPEM: {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "PEM encoded CA certificates trusted in addition to the system ones to verify the API server certificate, " +
+				"e.g. the CA of an inspecting proxy.",
+		},
+		attr.CACertFile: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(EnvCACertFile, nil),
+			Description: fmt.Sprintf("Path to a PEM file of CA certificates trusted in addition to the system ones to verify the API server certificate.\n"+
+				"Alternatively, this can be specified using the %s environment variable", EnvCACertFile),
+		},
+		attr.ClientCert: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{attr.ClientKey},
+			Description: fmt.Sprintf("PEM encoded client certificate presented for mutual TLS, it requires the `%s`. "+
+				"Use the `file` function to read it from disk.", attr.ClientKey),
+		},
+		attr.ClientKey: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			RequiredWith: []string{attr.ClientCert},
+			Description:  fmt.Sprintf("PEM encoded private key of the `%s`.", attr.ClientCert),
+		},
+		attr.ProxyURL: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(EnvProxyURL, nil),
+			Description: fmt.Sprintf("URL of the proxy the API requests are sent through, e.g. `http://proxy.corp:3128`. "+
+				"By default the HTTPS_PROXY environment variable is used.\n"+
+				"Alternatively, this can be specified using the %s environment variable", EnvProxyURL),
+		},
+		attr.NoProxy: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(EnvNoProxy, nil),
+			Description: fmt.Sprintf("Comma separated hosts, domains, IPs or CIDRs, optionally with a port, called without the proxy. "+
+				"A domain matches its subdomains and `*` matches every host.\n"+
+				"Alternatively, this can be specified using the %s environment variable", EnvNoProxy),
+		},
+		attr.InsecureSkipVerify: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(EnvInsecureSkipVerify, false),
+			Description: fmt.Sprintf("Disables the verification of the API server certificate. For lab use only, never in production. The default value is false.\n"+
+				"Alternatively, this can be specified using the %s environment variable", EnvInsecureSkipVerify),
+		},
 	}
 }
 
+// ErrNetworkNotSet is returned when configuring a provider without a network.
+var ErrNetworkNotSet = errors.New("unable to create anonymous Soc2bd client, network has to be provided")
+
+// providerConfig holds the provider settings shared by the SDK and the plugin framework providers.
+type providerConfig struct {
+	apiToken            string
+	apiTokenFile        string
+	apiTokenCommand     string
+	apiTokenCommandTTL  int
+	apiTokenAgentSocket string
+	network             string
+	url                 string
+	httpTimeout         int
+	httpMaxRetry        int
+	httpRateLimit       float64
+	httpRateLimitBurst  int
+	deletionProtection  string
+	caCertPEM           string
+	caCertFile          string
+	clientCert          string
+	clientKey           string
+	proxyURL            string
+	noProxy             string
+	insecureSkipVerify  bool
+}
+
+func (cfg providerConfig) newClient(ctx context.Context, version string) (*client.Client, error) {
+	if cfg.network == "" {
+		return nil, ErrNetworkNotSet
+	}
+
+	c := client.NewClient(
+		client.WithURL(cfg.url),
+		client.WithAPIToken(cfg.apiToken),
+		client.WithTokenSource(cfg.tokenSource()),
+		client.WithNetwork(cfg.network),
+		client.WithHTTPTimeout(time.Duration(cfg.httpTimeout)*time.Second),
+		client.WithHTTPMaxRetry(cfg.httpMaxRetry),
+		client.WithRateLimit(cfg.httpRateLimit, cfg.httpRateLimitBurst),
+		client.WithUserAgent(fmt.Sprintf("Soc2bdTF/%s", version)),
+		client.WithCACert(cfg.caCertPEM),
+		client.WithCACertFile(cfg.caCertFile),
+		client.WithClientCertificate(cfg.clientCert, cfg.clientKey),
+		client.WithProxy(cfg.proxyURL, cfg.noProxy),
+		client.WithInsecureSkipVerify(cfg.insecureSkipVerify),
+	)
+
+	if err := c.ConfigError(); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if cfg.insecureSkipVerify {
+		tflog.Warn(ctx, "The API server certificate is not verified, insecure_skip_verify is for lab use only")
+	}
+
+	tflog.Info(ctx, "Created Soc2bd client", map[string]interface{}{
+		"server_url":                 c.GraphqlServerURL,
+		client.LogFieldCorrelationID: c.CorrelationID(),
+	})
+
+	return c, nil
+}
+
+// metaKey identifies a provider configuration.
+type metaKey struct {
+	cfg     providerConfig
+	version string
+}
+
+// metaCache builds the meta once per provider configuration: the muxed SDK and plugin framework providers
+// are both configured with it and share a single client, so its rate limiter, correlation ID and token source.
+type metaCache struct {
+	mutex sync.Mutex
+	metas map[metaKey]*provider.Meta
+}
+
+//nolint:gochecknoglobals
+var configuredMetas = &metaCache{metas: make(map[metaKey]*provider.Meta)}
+
+func (cache *metaCache) get(ctx context.Context, cfg providerConfig, version string) (*provider.Meta, error) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	key := metaKey{cfg: cfg, version: version}
+	if meta, ok := cache.metas[key]; ok {
+		return meta, nil
+	}
+
+	meta, err := cfg.newMeta(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.metas[key] = meta
+
+	return meta, nil
+}
+
+// newMeta returns the meta the resources and data sources of both the SDK and the plugin framework providers get.
+func (cfg providerConfig) newMeta(ctx context.Context, version string) (*provider.Meta, error) {
+	c, err := cfg.newClient(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.Meta{
+		Client:             c,
+		DeletionProtection: cfg.deletionProtection,
+	}, nil
+}
+
 func configure(version string, _ *schema.Provider) func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		apiToken := d.Get(attr.APIToken).(string)
-		network := d.Get(attr.Network).(string)
-		url := d.Get(attr.URL).(string)
-		httpTimeout := d.Get(attr.HTTPTimeout).(int)
-		httpMaxRetry := d.Get(attr.HTTPMaxRetry).(int)
-
-		if network != "" {
-			return client.NewClient(url,
-					apiToken,
-					network,
-					time.Duration(httpTimeout)*time.Second,
-					httpMaxRetry,
-					version),
-				nil
+		cfg := providerConfig{
+			apiToken:            d.Get(attr.APIToken).(string),
+			apiTokenFile:        d.Get(attr.APITokenFile).(string),
+			apiTokenCommand:     d.Get(attr.APITokenCommand).(string),
+			apiTokenCommandTTL:  d.Get(attr.APITokenCommandTTL).(int),
+			apiTokenAgentSocket: d.Get(attr.APITokenAgentSocket).(string),
+			network:             d.Get(attr.Network).(string),
+			url:                 d.Get(attr.URL).(string),
+			httpTimeout:         d.Get(attr.HTTPTimeout).(int),
+			httpMaxRetry:        d.Get(attr.HTTPMaxRetry).(int),
+			httpRateLimit:       d.Get(attr.HTTPRateLimit).(float64),
+			httpRateLimitBurst:  d.Get(attr.HTTPRateLimitBurst).(int),
+			deletionProtection:  d.Get(attr.DeletionProtection).(string),
+			caCertPEM:           d.Get(attr.CACertPEM).(string),
+			caCertFile:          d.Get(attr.CACertFile).(string),
+			clientCert:          d.Get(attr.ClientCert).(string),
+			clientKey:           d.Get(attr.ClientKey).(string),
+			proxyURL:            d.Get(attr.ProxyURL).(string),
+			noProxy:             d.Get(attr.NoProxy).(string),
+			insecureSkipVerify:  d.Get(attr.InsecureSkipVerify).(bool),
 		}
 
-		return nil, diag.Diagnostics{
-			diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Unable to create Soc2bd client",
-				Detail:   "Unable to create anonymous Soc2bd client, network has to be provided",
-			},
+		meta, err := configuredMetas.get(ctx, cfg, version)
+		if err != nil {
+			return nil, diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Unable to create Soc2bd client",
+					Detail:   clientErrorDetail(err),
+				},
+			}
 		}
+
+		return meta, nil
+	}
+}
+
+// tokenSource returns the configured API token source, nil when the api_token is used.
+func (cfg providerConfig) tokenSource() client.TokenSource {
+	switch {
+	case cfg.apiTokenFile != "":
+		return client.NewFileTokenSource(cfg.apiTokenFile)
+	case cfg.apiTokenCommand != "":
+		return client.NewCommandTokenSource(cfg.apiTokenCommand, time.Duration(cfg.apiTokenCommandTTL)*time.Second)
+	case cfg.apiTokenAgentSocket != "":
+		return client.NewAgentTokenSource(cfg.apiTokenAgentSocket)
+	default:
+		return nil
 	}
 }
+
+// clientErrorDetail keeps the historical message of a missing network, the other errors are reported as is.
+func clientErrorDetail(err error) string {
+	if errors.Is(err, ErrNetworkNotSet) {
+		return "Unable to create anonymous Soc2bd client, network has to be provided"
+	}
+
+	return err.Error()
+}
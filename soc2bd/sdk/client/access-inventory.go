@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+)
+
+// ReadAccessInventory reads all the users, groups with their members and resources, resources,
+// service accounts and security policies.
+func (client *Client) ReadAccessInventory(ctx context.Context) (*model.AccessInventory, error) {
+	users, err := client.ReadUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := client.ReadGroups(ctx, nil)
+	if err != nil && !errors.Is(err, ErrGraphqlResultIsEmpty) {
+		return nil, err
+	}
+
+	resources, err := client.ReadResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceAccounts, err := client.ReadServiceAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := client.ReadSecurityPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AccessInventory{
+		Users:            users,
+		Groups:           groups,
+		Resources:        resources,
+		ServiceAccounts:  serviceAccounts,
+		SecurityPolicies: policies,
+	}, nil
+}
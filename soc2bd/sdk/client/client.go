@@ -0,0 +1,487 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hasura/go-graphql-client"
+)
+
+const (
+	EnvPageLimit = "SOC2BD_PAGE_LIMIT"
+	EnvAPIToken  = "SOC2BD_API_TOKEN" //#nosec
+
+	headerAPIKey        = "X-API-KEY"
+	headerAgent         = "User-Agent"
+	headerCorrelationID = "X-Correlation-Id"
+	headerAuthorization = "Authorization"
+
+	defaultPageLimit = 50
+)
+
+var (
+	ErrAPITokenNoSet = errors.New("api_token not set")
+
+	// A regular expression to match the error returned by net/http when the
+	// TLS certificate name is not match with input. This error isn't typed
+	// specifically so we resort to matching on the error string.
+	certNameNotMatchMacErrorRe   = regexp.MustCompile(`certificate name does not match input`)
+	certNameNotMatchLinuxErrorRe = regexp.MustCompile(`certificate is valid for`)
+)
+
+type Client struct {
+	GraphqlClient    *graphql.Client
+	HTTPClient       *http.Client
+	GraphqlServerURL string
+	APIServerURL     string
+	userAgent        string
+	pageLimit        int
+	correlationID    string
+	transport        *transport
+}
+
+type transport struct {
+	underlineRoundTripper http.RoundTripper
+	tokens                TokenSource
+	userAgent             string
+	correlationID         string
+	limiter               *rateLimiter
+	// configErr fails every request when the TLS or proxy options are invalid
+	configErr error
+	// lastToken is the last token sent, it's masked in the logs
+	lastToken atomic.Value
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.configErr != nil {
+		return nil, t.configErr
+	}
+
+	token, err := t.token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.limiter.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	req.Header.Set(headerAgent, t.userAgent)
+	req.Header.Set(headerCorrelationID, t.correlationID)
+
+	resp, err := t.send(req, token)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized && rejectsAPIToken(req) {
+		resp, err = t.retryWithRefreshedToken(req, token, resp)
+	}
+
+	requestInfoFrom(req.Context()).record(resp)
+
+	return resp, err
+}
+
+func (t *transport) token(ctx context.Context) (string, error) {
+	token, err := t.tokens.Token(ctx)
+	if err != nil {
+		return "", err //nolint:wrapcheck
+	}
+
+	t.lastToken.Store(token)
+
+	return token, nil
+}
+
+func (t *transport) send(req *http.Request, token string) (*http.Response, error) {
+	req.Header.Set(headerAPIKey, token)
+
+	return t.underlineRoundTripper.RoundTrip(req) //nolint:wrapcheck
+}
+
+// rejectsAPIToken reports whether a 401 response is about the API token, the requests carrying
+// their own credentials, e.g. the connector tokens validation, are rejected for those.
+func rejectsAPIToken(req *http.Request) bool {
+	return req.Header.Get(headerAuthorization) == ""
+}
+
+// retryWithRefreshedToken sends the request once more when the token source has a new token
+// after the rejected one, e.g. when it was rotated during the run.
+func (t *transport) retryWithRefreshedToken(req *http.Request, rejected string, resp *http.Response) (*http.Response, error) {
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+
+	t.tokens.Invalidate(rejected)
+
+	token, err := t.token(req.Context())
+	if err != nil || token == rejected {
+		return resp, nil //nolint:nilerr
+	}
+
+	retry := req.Clone(req.Context())
+
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return resp, nil //nolint:nilerr
+		}
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	tflog.SubsystemWarn(req.Context(), LogSubsystem, "API token rejected, retrying with a refreshed token", map[string]interface{}{
+		LogFieldURL: req.URL.String(),
+	})
+
+	return t.send(retry, token)
+}
+
+// currentToken returns the last token sent, or the static token before the first request.
+func (t *transport) currentToken() string {
+	if token, _ := t.lastToken.Load().(string); token != "" {
+		return token
+	}
+
+	if static, ok := t.tokens.(staticTokenSource); ok {
+		token, _ := static.Token(context.Background())
+
+		return token
+	}
+
+	return ""
+}
+
+type ctxKeyRequestInfo struct{}
+
+// requestInfo collects the outcome of the http requests made for a single API call,
+// as the graphql client reports failed responses only as a message.
+type requestInfo struct {
+	mutex      sync.Mutex
+	attempts   int
+	statusCode int
+	retryAfter time.Duration
+}
+
+func withRequestInfo(ctx context.Context) (context.Context, *requestInfo) {
+	info := &requestInfo{}
+
+	return context.WithValue(ctx, ctxKeyRequestInfo{}, info), info
+}
+
+func requestInfoFrom(ctx context.Context) *requestInfo {
+	if ctx == nil {
+		return nil
+	}
+
+	info, _ := ctx.Value(ctxKeyRequestInfo{}).(*requestInfo)
+
+	return info
+}
+
+func (i *requestInfo) record(resp *http.Response) {
+	if i == nil {
+		return
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	i.attempts++
+
+	if resp == nil {
+		return
+	}
+
+	i.statusCode = resp.StatusCode
+	i.retryAfter, _ = parseRetryAfter(resp.Header, time.Now())
+}
+
+// wrapError classifies the error by the GraphQL error code, falling back to the status of the last response.
+func (i *requestInfo) wrapError(err error) error {
+	if i == nil || err == nil {
+		return err
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	kind := errorKindFromGraphql(err)
+	if kind == ErrorKindUnknown && i.statusCode != http.StatusOK {
+		kind = errorKindFromStatus(i.statusCode)
+	}
+
+	switch kind {
+	case ErrorKindUnknown:
+		return err
+	case ErrorKindThrottled:
+		return NewThrottledError(err, i.retryAfter)
+	default:
+		return NewRequestError(err, kind, i.statusCode)
+	}
+}
+
+func newTransport(underlineRoundTripper http.RoundTripper, tokens TokenSource, userAgent, correlationID string, limiter *rateLimiter) *transport {
+	return &transport{
+		underlineRoundTripper: underlineRoundTripper,
+		tokens:                tokens,
+		userAgent:             userAgent,
+		correlationID:         correlationID,
+		limiter:               limiter,
+	}
+}
+
+func (s *serverURL) newGraphqlServerURL() string {
+	return fmt.Sprintf("%s/api/graphql/", s.url)
+}
+
+func (s *serverURL) newAPIServerURL() string {
+	return fmt.Sprintf("%s/api/v4", s.url)
+}
+
+type serverURL struct {
+	url string
+}
+
+func newServerURL(network, url string) serverURL {
+	// url with explicit scheme is used as is, e.g. a local test server
+	if strings.HasPrefix(url, "http://") || strings.HasPrefix(url, "https://") {
+		return serverURL{
+			url: strings.TrimSuffix(url, "/"),
+		}
+	}
+
+	return serverURL{
+		url: fmt.Sprintf("https://%s.%s", network, url),
+	}
+}
+
+func customRetryPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	// do not retry if API token can't be read or the TLS and proxy options are invalid
+	if errors.Is(err, ErrAPITokenNoSet) || errors.Is(err, ErrTokenSource) ||
+		errors.Is(err, ErrInvalidTLSConfig) || errors.Is(err, ErrInvalidProxyConfig) {
+		return false, err
+	}
+
+	// do not retry if there is an issue with TLS certificate
+	if isCertificateError(err) {
+		return false, err
+	}
+
+	if err != nil {
+		if v, ok := err.(*url.Error); ok { //nolint:errorlint
+			if certNameNotMatchMacErrorRe.MatchString(v.Error()) ||
+				certNameNotMatchLinuxErrorRe.MatchString(v.Error()) {
+				return false, v
+			}
+		}
+	}
+
+	return retryablehttp.DefaultRetryPolicy(ctx, resp, err) //nolint
+}
+
+// NewClient creates a Soc2bd API client configured with the given options.
+func NewClient(opts ...Option) *Client {
+	cfg := newConfig(opts...)
+	correlationID, _ := uuid.GenerateUUID()
+
+	sURL := newServerURL(cfg.network, cfg.url)
+	limiter := newRateLimiter(cfg.rateLimit, cfg.rateLimitBurst)
+	retryableClient := retryablehttp.NewClient()
+	retryableClient.CheckRetry = customRetryPolicy
+	retryableClient.Backoff = newBackoff(limiter)
+	retryableClient.RetryMax = cfg.httpMaxRetry
+	retryableClient.Logger = nil
+	retryableClient.RequestLogHook = logRequest
+	retryableClient.ResponseLogHook = logResponse
+	retryableClient.HTTPClient.Timeout = cfg.httpTimeout
+	transport := newTransport(retryableClient.HTTPClient.Transport, cfg.tokenSource(), cfg.userAgent, correlationID, limiter)
+
+	if httpTransport, ok := retryableClient.HTTPClient.Transport.(*http.Transport); ok {
+		transport.configErr = configureHTTPTransport(httpTransport, cfg)
+	}
+
+	retryableClient.HTTPClient.Transport = transport
+
+	httpClient := retryableClient.StandardClient()
+
+	client := Client{
+		HTTPClient:       httpClient,
+		GraphqlServerURL: sURL.newGraphqlServerURL(),
+		APIServerURL:     sURL.newAPIServerURL(),
+		GraphqlClient: graphql.NewClient(sURL.newGraphqlServerURL(), httpClient).WithRequestModifier(func(request *http.Request) {
+			request.Header.Set(headerCorrelationID, correlationID)
+		}),
+		userAgent:     cfg.userAgent,
+		pageLimit:     cfg.pageLimit,
+		correlationID: correlationID,
+		transport:     transport,
+	}
+
+	return &client
+}
+
+// logRequest logs every attempt to call the API, the retries are logged as warnings.
+func logRequest(_ retryablehttp.Logger, req *http.Request, retryNumber int) {
+	fields := map[string]interface{}{
+		LogFieldURL:   req.URL.String(),
+		LogFieldRetry: retryNumber,
+	}
+
+	if retryNumber == 0 {
+		tflog.SubsystemTrace(req.Context(), LogSubsystem, "Sending request", fields)
+
+		return
+	}
+
+	tflog.SubsystemWarn(req.Context(), LogSubsystem, "Retrying request", fields)
+}
+
+func logResponse(_ retryablehttp.Logger, resp *http.Response) {
+	if resp.Request == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(resp.Request.Context(), LogSubsystem, "Received response", map[string]interface{}{
+		LogFieldURL:        resp.Request.URL.String(),
+		LogFieldStatusCode: resp.StatusCode,
+	})
+}
+
+// ConfigError returns the error of the invalid TLS or proxy options, every request fails with it.
+func (client *Client) ConfigError() error {
+	return client.transport.configErr
+}
+
+func getPageLimit() int {
+	str := os.Getenv(EnvPageLimit)
+
+	val, err := strconv.Atoi(str)
+	if err != nil {
+		return defaultPageLimit
+	}
+
+	return val
+}
+
+func (client *Client) post(ctx context.Context, url string, payload interface{}, headers map[string]string) ([]byte, error) {
+	var body io.Reader
+
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		body = bytes.NewBuffer(data)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		client.APIServerURL+url,
+		body,
+	)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	for key, val := range headers {
+		req.Header.Set(key, val)
+	}
+
+	return client.doRequest(req)
+}
+
+func (client *Client) doRequest(req *http.Request) ([]byte, error) {
+	req.Header.Set("content-type", "application/json")
+	req.Header.Set(headerAgent, client.userAgent)
+	req.Header.Set(headerCorrelationID, client.correlationID)
+
+	ctx := client.logContext(req.Context(), req.URL.Path)
+	ctx = MaskLogValues(ctx, LogSubsystem, strings.TrimPrefix(req.Header.Get(headerAuthorization), "Bearer "))
+	ctx, info := withRequestInfo(ctx)
+	res, err := client.HTTPClient.Do(req.WithContext(ctx))
+
+	if err != nil {
+		tflog.SubsystemError(ctx, LogSubsystem, "Request failed", map[string]interface{}{"error": err.Error()})
+
+		return nil, fmt.Errorf("can't execute http request: %w", info.wrapError(err))
+	}
+
+	defer func(closer io.Closer) {
+		if err := closer.Close(); err != nil {
+			tflog.SubsystemError(ctx, LogSubsystem, "Failed to close the response body", map[string]interface{}{"error": err.Error()})
+		}
+	}(res.Body)
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("can't read response body: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, NewHTTPError(req.URL.String(), res.StatusCode, body)
+	}
+
+	return body, nil
+}
+
+type MutationResponse interface {
+	OK() bool
+	ErrorStr() string
+	ResponseWithPayload
+}
+
+func (client *Client) mutate(ctx context.Context, resp MutationResponse, variables map[string]any, opr operation, attrs ...attr) error {
+	ctx, info := withRequestInfo(client.logContext(ctx, opr.String(), attrs...))
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Calling API mutation", logVariables(variables))
+
+	err := client.GraphqlClient.Mutate(ctx, resp, variables, graphql.OperationName(opr.String()))
+	if err != nil {
+		return client.logError(ctx, opr.apiError(info.wrapError(err), attrs...))
+	}
+
+	if !resp.OK() {
+		return client.logError(ctx, opr.apiError(NewMutationError(resp.ErrorStr()), attrs...))
+	}
+
+	if resp.IsEmpty() {
+		return opr.apiError(ErrGraphqlResultIsEmpty, attrs...)
+	}
+
+	return nil
+}
+
+type ResponseWithPayload interface {
+	IsEmpty() bool
+}
+
+func (client *Client) query(ctx context.Context, resp ResponseWithPayload, variables map[string]any, opr operation, attrs ...attr) error {
+	ctx, info := withRequestInfo(client.logContext(ctx, opr.String(), attrs...))
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Calling API query", logVariables(variables))
+
+	err := client.GraphqlClient.Query(ctx, resp, variables, graphql.OperationName(opr.String()))
+	if err != nil {
+		return client.logError(ctx, opr.apiError(info.wrapError(err), attrs...))
+	}
+
+	if resp.IsEmpty() {
+		return opr.apiError(ErrGraphqlResultIsEmpty, attrs...)
+	}
+
+	return nil
+}
@@ -15,8 +15,11 @@ import (
 
 func newTestClient() *Client {
 	return NewClient(
-		"twindev.com", "xxxx", "test",
-		time.Duration(1)*time.Second, 0, "test",
+		WithURL("twindev.com"),
+		WithAPIToken("xxxx"),
+		WithNetwork("test"),
+		WithHTTPTimeout(time.Duration(1)*time.Second),
+		WithHTTPMaxRetry(0),
 	)
 }
 
@@ -77,8 +80,10 @@ func TestClientAPITokenNotSet(t *testing.T) {
 	defer os.Setenv(EnvAPIToken, apiToken)
 
 	client := NewClient(
-		"twindev.com", "", "test",
-		time.Duration(1)*time.Second, 0, "test",
+		WithURL("twindev.com"),
+		WithNetwork("test"),
+		WithHTTPTimeout(time.Duration(1)*time.Second),
+		WithHTTPMaxRetry(0),
 	)
 
 	_, err := client.post(context.TODO(), "/hello", "hello", nil)
@@ -93,8 +98,11 @@ func TestClientAPITokenNotSet(t *testing.T) {
 
 func TestClientInvalidServerAddress(t *testing.T) {
 	client := NewClient(
-		"beamreach.soc2bd.com", "XXXXX", "beamreach",
-		time.Duration(10)*time.Second, 3, "test",
+		WithURL("beamreach.soc2bd.com"),
+		WithAPIToken("XXXXX"),
+		WithNetwork("beamreach"),
+		WithHTTPTimeout(time.Duration(10)*time.Second),
+		WithHTTPMaxRetry(3),
 	)
 
 	internal := client.HTTPClient.Transport.(*retryablehttp.RoundTripper)
@@ -107,3 +115,67 @@ func TestClientInvalidServerAddress(t *testing.T) {
 	assert.ErrorContains(t, err, `x509`)
 	assert.ErrorContains(t, err, `certificate`)
 }
+
+func TestNewServerURL(t *testing.T) {
+	cases := []struct {
+		network  string
+		url      string
+		expected string
+	}{
+		{network: "test", url: "soc2bd.com", expected: "https://test.soc2bd.com"},
+		{network: "test", url: "http://127.0.0.1:8080", expected: "http://127.0.0.1:8080"},
+		{network: "test", url: "https://localhost/", expected: "https://localhost"},
+	}
+
+	for _, c := range cases {
+		sURL := newServerURL(c.network, c.url)
+
+		assert.Equal(t, c.expected, sURL.url)
+		assert.Equal(t, c.expected+"/api/graphql/", sURL.newGraphqlServerURL())
+	}
+}
+
+func TestNewClientOptions(t *testing.T) {
+	t.Setenv(EnvPageLimit, "")
+
+	cfg := newConfig()
+
+	assert.Equal(t, DefaultURL, cfg.url)
+	assert.Equal(t, DefaultHTTPTimeout, cfg.httpTimeout)
+	assert.Equal(t, DefaultHTTPMaxRetry, cfg.httpMaxRetry)
+	assert.Equal(t, DefaultUserAgent(), cfg.userAgent)
+	assert.Equal(t, defaultPageLimit, cfg.pageLimit)
+
+	cfg = newConfig(
+		WithURL("example.com"),
+		WithNetwork("autoco"),
+		WithAPIToken("token"),
+		WithHTTPTimeout(time.Minute),
+		WithHTTPMaxRetry(1),
+		WithUserAgent("Tool/1.0"),
+		WithPageLimit(10),
+		WithCACert("ca"),
+		WithCACertFile("ca.pem"),
+		WithClientCertificate("cert", "key"),
+		WithProxy("http://proxy.corp:3128", "localhost"),
+		WithInsecureSkipVerify(true),
+	)
+
+	assert.Equal(t, "example.com", cfg.url)
+	assert.Equal(t, "autoco", cfg.network)
+	assert.Equal(t, "token", cfg.apiToken)
+	assert.Equal(t, time.Minute, cfg.httpTimeout)
+	assert.Equal(t, 1, cfg.httpMaxRetry)
+	assert.Equal(t, "Tool/1.0", cfg.userAgent)
+	assert.Equal(t, 10, cfg.pageLimit)
+	assert.Equal(t, "ca", cfg.caCertPEM)
+	assert.Equal(t, "ca.pem", cfg.caCertFile)
+	assert.Equal(t, "cert", cfg.clientCertPEM)
+	assert.Equal(t, "key", cfg.clientKeyPEM)
+	assert.Equal(t, "http://proxy.corp:3128", cfg.proxyURL)
+	assert.Equal(t, "localhost", cfg.noProxy)
+	assert.True(t, cfg.insecureSkipVerify)
+
+	client := NewClient(WithURL("example.com"), WithNetwork("autoco"))
+	assert.Equal(t, "https://autoco.example.com/api/graphql/", client.GraphqlServerURL)
+}
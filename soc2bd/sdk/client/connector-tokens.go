@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client/query"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client/query"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/hasura/go-graphql-client"
 )
 
@@ -17,7 +17,7 @@ func (client *Client) VerifyConnectorTokens(ctx context.Context, refreshToken, a
 	}
 
 	headers := map[string]string{
-		"Authorization": fmt.Sprintf("Bearer %s", accessToken),
+		headerAuthorization: fmt.Sprintf("Bearer %s", accessToken),
 	}
 
 	_, err := client.post(ctx, "/connector/validate_tokens", payload, headers)
@@ -31,6 +31,7 @@ func (client *Client) VerifyConnectorTokens(ctx context.Context, refreshToken, a
 func (client *Client) GenerateConnectorTokens(ctx context.Context, connectorID string) (*model.ConnectorTokens, error) {
 	variables := newVars(gqlID(connectorID, "connectorId"))
 	response := query.GenerateConnectorTokens{}
+	ctx = client.logContext(ctx, "generateConnectorTokens", attr{id: connectorID})
 
 	err := client.GraphqlClient.Mutate(ctx, &response, variables, graphql.OperationName("generateConnectorTokens"))
 	if err != nil {
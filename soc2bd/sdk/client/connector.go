@@ -3,8 +3,8 @@ package client
 import (
 	"context"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client/query"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client/query"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 )
 
 func (client *Client) CreateConnector(ctx context.Context, input *model.Connector) (*model.Connector, error) {
@@ -85,7 +85,7 @@ func (client *Client) ReadConnectors(ctx context.Context) ([]*model.Connector, e
 	)
 
 	response := query.ReadConnectors{}
-	if err := client.query(ctx, &response, variables, opr.withCustomName("readConnectors"), attr{id: "All"}); err != nil {
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readConnectors"), attr{id: idAll}); err != nil {
 		return nil, err
 	}
 
@@ -102,7 +102,7 @@ func (client *Client) readConnectorsAfter(ctx context.Context, variables map[str
 	variables[query.CursorConnectors] = cursor
 
 	response := query.ReadConnectors{}
-	if err := client.query(ctx, &response, variables, opr.withCustomName("readConnectors"), attr{id: "All"}); err != nil {
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readConnectors"), attr{id: idAll}); err != nil {
 		return nil, err
 	}
 
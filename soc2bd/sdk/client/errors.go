@@ -0,0 +1,310 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+var (
+	ErrGraphqlIDIsEmpty          = errors.New("id is empty")
+	ErrGraphqlNameIsEmpty        = errors.New("name is empty")
+	ErrGraphqlEmptyBothNameAndID = errors.New("both name and id should not be empty")
+	ErrGraphqlResultIsEmpty      = errors.New("query result is empty")
+	ErrGraphqlConnectorIDIsEmpty = errors.New("connector id is empty")
+	ErrGraphqlNetworkIDIsEmpty   = errors.New("network id is empty")
+	ErrGraphqlNetworkNameIsEmpty = errors.New("network name is empty")
+	ErrGraphqlEmailIsEmpty       = errors.New("email is empty")
+	ErrTooManyRequests           = errors.New("too many requests")
+)
+
+// ErrorKind is the category of an APIError.
+type ErrorKind string
+
+const (
+	ErrorKindUnknown          ErrorKind = ""
+	ErrorKindNotFound         ErrorKind = "not found"
+	ErrorKindPermissionDenied ErrorKind = "permission denied"
+	ErrorKindValidation       ErrorKind = "validation"
+	ErrorKindConflict         ErrorKind = "conflict"
+	ErrorKindThrottled        ErrorKind = "throttled"
+	ErrorKindServerError      ErrorKind = "server error"
+)
+
+// graphqlErrorCodeKinds maps the GraphQL errors[].extensions.code values to the error kinds.
+var graphqlErrorCodeKinds = map[string]ErrorKind{ //nolint:gochecknoglobals
+	"NOT_FOUND":                 ErrorKindNotFound,
+	"FORBIDDEN":                 ErrorKindPermissionDenied,
+	"UNAUTHORIZED":              ErrorKindPermissionDenied,
+	"UNAUTHENTICATED":           ErrorKindPermissionDenied,
+	"PERMISSION_DENIED":         ErrorKindPermissionDenied,
+	"ACCESS_DENIED":             ErrorKindPermissionDenied,
+	"BAD_USER_INPUT":            ErrorKindValidation,
+	"BAD_REQUEST":               ErrorKindValidation,
+	"INVALID_ARGUMENT":          ErrorKindValidation,
+	"VALIDATION":                ErrorKindValidation,
+	"VALIDATION_ERROR":          ErrorKindValidation,
+	"GRAPHQL_VALIDATION_FAILED": ErrorKindValidation,
+	"GRAPHQL_PARSE_FAILED":      ErrorKindValidation,
+	"CONFLICT":                  ErrorKindConflict,
+	"ALREADY_EXISTS":            ErrorKindConflict,
+	"THROTTLED":                 ErrorKindThrottled,
+	"RATE_LIMITED":              ErrorKindThrottled,
+	"TOO_MANY_REQUESTS":         ErrorKindThrottled,
+	"INTERNAL_SERVER_ERROR":     ErrorKindServerError,
+	"INTERNAL":                  ErrorKindServerError,
+	"SERVICE_UNAVAILABLE":       ErrorKindServerError,
+}
+
+// errorKindFromStatus classifies a failed http response by its status code.
+func errorKindFromStatus(statusCode int) ErrorKind {
+	switch {
+	case statusCode == http.StatusNotFound:
+		return ErrorKindNotFound
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return ErrorKindPermissionDenied
+	case statusCode == http.StatusBadRequest || statusCode == http.StatusUnprocessableEntity:
+		return ErrorKindValidation
+	case statusCode == http.StatusConflict || statusCode == http.StatusPreconditionFailed:
+		return ErrorKindConflict
+	case statusCode == http.StatusTooManyRequests:
+		return ErrorKindThrottled
+	case statusCode >= http.StatusInternalServerError:
+		return ErrorKindServerError
+	}
+
+	return ErrorKindUnknown
+}
+
+// errorKindFromGraphql classifies GraphQL errors by the code set in their extensions,
+// the first recognised code wins.
+func errorKindFromGraphql(err error) ErrorKind {
+	var gqlErrors graphql.Errors
+	if !errors.As(err, &gqlErrors) {
+		return ErrorKindUnknown
+	}
+
+	for _, gqlErr := range gqlErrors {
+		code, _ := gqlErr.Extensions["code"].(string)
+		if kind, ok := graphqlErrorCodeKinds[strings.ToUpper(code)]; ok {
+			return kind
+		}
+	}
+
+	return ErrorKindUnknown
+}
+
+// RequestError is a failed API request classified by the GraphQL error code or the http status.
+type RequestError struct {
+	Kind         ErrorKind
+	StatusCode   int
+	WrappedError error
+}
+
+func NewRequestError(wrappedError error, kind ErrorKind, statusCode int) *RequestError {
+	return &RequestError{
+		Kind:         kind,
+		StatusCode:   statusCode,
+		WrappedError: wrappedError,
+	}
+}
+
+func (e *RequestError) Error() string {
+	msg := string(e.Kind)
+	if e.StatusCode != 0 {
+		msg += fmt.Sprintf(" (status %d)", e.StatusCode)
+	}
+
+	if e.WrappedError != nil {
+		msg += ": " + e.WrappedError.Error()
+	}
+
+	return msg
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.WrappedError
+}
+
+// ThrottledError is returned when the API kept rejecting requests with 429 Too Many Requests.
+type ThrottledError struct {
+	RetryAfter   time.Duration
+	WrappedError error
+}
+
+func NewThrottledError(wrappedError error, retryAfter time.Duration) *ThrottledError {
+	return &ThrottledError{
+		RetryAfter:   retryAfter,
+		WrappedError: wrappedError,
+	}
+}
+
+func (e *ThrottledError) Error() string {
+	msg := "request throttled by Soc2bd API"
+	if e.RetryAfter > 0 {
+		msg += fmt.Sprintf(", retry after %s", e.RetryAfter)
+	}
+
+	if e.WrappedError != nil {
+		msg += ": " + e.WrappedError.Error()
+	}
+
+	return msg
+}
+
+func (e *ThrottledError) Unwrap() error {
+	return e.WrappedError
+}
+
+func (e *ThrottledError) Is(target error) bool {
+	return target == ErrTooManyRequests //nolint:errorlint
+}
+
+type HTTPError struct {
+	RequestURI string
+	StatusCode int
+	Body       []byte
+}
+
+func NewHTTPError(requestURI string, statusCode int, body []byte) *HTTPError {
+	return &HTTPError{
+		RequestURI: requestURI,
+		StatusCode: statusCode,
+		Body:       body,
+	}
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("request %s failed, status %d, body %s", e.RequestURI, e.StatusCode, e.Body)
+}
+
+type APIError struct {
+	WrappedError error
+	Operation    string
+	Resource     string
+	ID           graphql.ID
+	Name         string
+}
+
+func NewAPIErrorWithID(wrappedError error, operation, resource, id string) *APIError {
+	return &APIError{
+		WrappedError: wrappedError,
+		Operation:    operation,
+		Resource:     resource,
+		ID:           graphql.ID(id),
+	}
+}
+
+func NewAPIErrorWithName(wrappedError error, operation, resource, name string) *APIError {
+	return &APIError{
+		WrappedError: wrappedError,
+		Operation:    operation,
+		Resource:     resource,
+		Name:         name,
+	}
+}
+
+func NewAPIError(wrappedError error, operation, resource string) *APIError {
+	return &APIError{
+		WrappedError: wrappedError,
+		Operation:    operation,
+		Resource:     resource,
+	}
+}
+
+func (e *APIError) Error() string {
+	var args = make([]interface{}, 0, 2) //nolint:gomnd
+	args = append(args, e.Operation, e.Resource)
+
+	var format = "failed to %s %s"
+
+	if e.ID != "" {
+		format += " with id %s"
+
+		args = append(args, e.ID)
+	}
+
+	if e.Name != "" {
+		format += " with name %s"
+
+		args = append(args, e.Name)
+	}
+
+	if e.WrappedError != nil {
+		format += ": %s"
+
+		args = append(args, e.WrappedError)
+	}
+
+	return fmt.Sprintf(format, args...)
+}
+
+func (e *APIError) Unwrap() error {
+	return e.WrappedError
+}
+
+// Kind returns the category of the error.
+// An empty result is a not found error only when reading a single entity by its id,
+// as list queries and mutations may legitimately return nothing.
+func (e *APIError) Kind() ErrorKind {
+	var (
+		requestErr *RequestError
+		httpErr    *HTTPError
+	)
+
+	switch {
+	case errors.As(e.WrappedError, &requestErr):
+		return requestErr.Kind
+	case errors.Is(e.WrappedError, ErrTooManyRequests):
+		return ErrorKindThrottled
+	case errors.As(e.WrappedError, &httpErr):
+		return errorKindFromStatus(httpErr.StatusCode)
+	case errors.Is(e.WrappedError, ErrGraphqlResultIsEmpty) && e.Operation == operationRead &&
+		e.ID != "" && e.ID != graphql.ID(idAll):
+		return ErrorKindNotFound
+	}
+
+	return ErrorKindUnknown
+}
+
+// ErrorKindOf returns the category of the API error, if err is one.
+func ErrorKindOf(err error) ErrorKind {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Kind()
+	}
+
+	return ErrorKindUnknown
+}
+
+// IsNotFound reports whether the entity requested from the API doesn't exist.
+func IsNotFound(err error) bool {
+	return ErrorKindOf(err) == ErrorKindNotFound
+}
+
+// IgnoreEmptyResult treats an empty list read from the API as no objects.
+func IgnoreEmptyResult(err error) error {
+	if errors.Is(err, ErrGraphqlResultIsEmpty) {
+		return nil
+	}
+
+	return err
+}
+
+type MutationError struct {
+	Message string
+}
+
+func NewMutationError(message string) *MutationError {
+	return &MutationError{
+		Message: message,
+	}
+}
+
+func (e *MutationError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientErrorKindFromStatus(t *testing.T) {
+	cases := []struct {
+		statusCode int
+		expected   ErrorKind
+	}{
+		{statusCode: http.StatusUnauthorized, expected: ErrorKindPermissionDenied},
+		{statusCode: http.StatusForbidden, expected: ErrorKindPermissionDenied},
+		{statusCode: http.StatusNotFound, expected: ErrorKindNotFound},
+		{statusCode: http.StatusConflict, expected: ErrorKindConflict},
+		{statusCode: http.StatusBadRequest, expected: ErrorKindValidation},
+		{statusCode: http.StatusBadGateway, expected: ErrorKindServerError},
+	}
+
+	for _, c := range cases {
+		srv := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			writer.WriteHeader(c.statusCode)
+		}))
+
+		client := NewClient(WithURL(srv.URL), WithAPIToken("token"), WithHTTPMaxRetry(0))
+
+		_, err := client.ReadResource(context.Background(), "id")
+		assert.Equal(t, c.expected, ErrorKindOf(err), err.Error())
+
+		_, err = client.post(context.Background(), "/hello", "hello", nil)
+		assert.Equal(t, c.expected, ErrorKindOf(resourceResource.read().apiError(err)), err.Error())
+
+		srv.Close()
+	}
+}
+
+func TestIgnoreEmptyResult(t *testing.T) {
+	assert.NoError(t, IgnoreEmptyResult(nil))
+	assert.NoError(t, IgnoreEmptyResult(resourceGroup.read().apiError(ErrGraphqlResultIsEmpty)))
+	assert.ErrorIs(t, IgnoreEmptyResult(ErrGraphqlIDIsEmpty), ErrGraphqlIDIsEmpty)
+}
@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client/query"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client/query"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 )
 
 func (client *Client) CreateGroup(ctx context.Context, input *model.Group) (*model.Group, error) {
@@ -20,6 +20,7 @@ func (client *Client) CreateGroup(ctx context.Context, input *model.Group) (*mod
 		gqlIDs(input.Users, "userIds"),
 		gqlNullableID(input.SecurityPolicyID, "securityPolicyId"),
 		cursor(query.CursorUsers),
+		cursor(query.CursorResources),
 		pageLimit(client.pageLimit),
 	)
 
@@ -45,6 +46,7 @@ func (client *Client) ReadGroup(ctx context.Context, groupID string) (*model.Gro
 	variables := newVars(
 		gqlID(groupID),
 		cursor(query.CursorUsers),
+		cursor(query.CursorResources),
 		pageLimit(client.pageLimit),
 	)
 
@@ -53,13 +55,15 @@ func (client *Client) ReadGroup(ctx context.Context, groupID string) (*model.Gro
 		return nil, err
 	}
 
-	if err := response.Group.Users.FetchPages(ctx, client.readGroupUsersAfter, variables); err != nil {
-		return nil, err //nolint
+	if err := client.fetchGroupInternalResources(ctx, response.Group); err != nil {
+		return nil, err
 	}
 
 	return response.ToModel(), nil
 }
 
+// ReadGroups reads the groups with all their users and resources,
+// use ReadShallowGroups when only the groups themselves are needed.
 func (client *Client) ReadGroups(ctx context.Context, filter *model.GroupsFilter) ([]*model.Group, error) {
 	opr := resourceGroup.read()
 
@@ -67,12 +71,13 @@ func (client *Client) ReadGroups(ctx context.Context, filter *model.GroupsFilter
 		gqlNullable(query.NewGroupFilterInput(filter), "filter"),
 		cursor(query.CursorGroups),
 		cursor(query.CursorUsers),
+		cursor(query.CursorResources),
 		pageLimit(client.pageLimit),
 	)
 
 	response := query.ReadGroups{}
 	if err := client.query(ctx, &response, variables, opr.withCustomName("readGroups"),
-		attr{id: "All", name: filter.GetName()}); err != nil {
+		attr{id: idAll, name: filter.GetName()}); err != nil {
 		return nil, err
 	}
 
@@ -80,6 +85,12 @@ func (client *Client) ReadGroups(ctx context.Context, filter *model.GroupsFilter
 		return nil, err //nolint
 	}
 
+	for _, edge := range response.Edges {
+		if err := client.fetchGroupInternalResources(ctx, edge.Node); err != nil {
+			return nil, err
+		}
+	}
+
 	return response.ToModel(), nil
 }
 
@@ -89,13 +100,59 @@ func (client *Client) readGroupsAfter(ctx context.Context, variables map[string]
 	variables[query.CursorGroups] = cursor
 
 	response := query.ReadGroups{}
-	if err := client.query(ctx, &response, variables, opr.withCustomName("readGroups"), attr{id: "All"}); err != nil {
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readGroups"), attr{id: idAll}); err != nil {
 		return nil, err
 	}
 
 	return &response.PaginatedResource, nil
 }
 
+// ReadShallowGroups reads the groups without their users and resources.
+func (client *Client) ReadShallowGroups(ctx context.Context, filter *model.GroupsFilter) ([]*model.Group, error) {
+	opr := resourceGroup.read()
+
+	variables := newVars(
+		gqlNullable(query.NewGroupFilterInput(filter), "filter"),
+		cursor(query.CursorGroups),
+		pageLimit(client.pageLimit),
+	)
+
+	response := query.ReadShallowGroups{}
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readShallowGroups"),
+		attr{id: idAll, name: filter.GetName()}); err != nil {
+		return nil, err
+	}
+
+	if err := response.FetchPages(ctx, client.readShallowGroupsAfter, variables); err != nil {
+		return nil, err //nolint
+	}
+
+	return response.ToModel(), nil
+}
+
+func (client *Client) readShallowGroupsAfter(ctx context.Context, variables map[string]interface{}, cursor string) (*query.PaginatedResource[*query.ShallowGroupEdge], error) {
+	opr := resourceGroup.read()
+
+	variables[query.CursorGroups] = cursor
+
+	response := query.ReadShallowGroups{}
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readShallowGroups"), attr{id: idAll}); err != nil {
+		return nil, err
+	}
+
+	return &response.PaginatedResource, nil
+}
+
+// fetchGroupInternalResources fetches the remaining pages of the group users and resources.
+func (client *Client) fetchGroupInternalResources(ctx context.Context, group *query.GqlGroup) error {
+	err := group.Users.FetchPages(ctx, client.readGroupUsersAfter, newVars(gqlID(group.ID), pageLimit(client.pageLimit)))
+	if err != nil {
+		return err //nolint
+	}
+
+	return group.Resources.FetchPages(ctx, client.readGroupResourcesAfter, newVars(gqlID(group.ID), pageLimit(client.pageLimit))) //nolint
+}
+
 func (client *Client) UpdateGroup(ctx context.Context, input *model.Group) (*model.Group, error) {
 	opr := resourceGroup.update()
 
@@ -113,6 +170,7 @@ func (client *Client) UpdateGroup(ctx context.Context, input *model.Group) (*mod
 		gqlIDs(input.Users, "addedUserIds"),
 		gqlNullableID(input.SecurityPolicyID, "securityPolicyId"),
 		cursor(query.CursorUsers),
+		cursor(query.CursorResources),
 		pageLimit(client.pageLimit),
 	)
 
@@ -121,9 +179,8 @@ func (client *Client) UpdateGroup(ctx context.Context, input *model.Group) (*mod
 		return nil, err
 	}
 
-	if err := response.Entity.Users.FetchPages(ctx,
-		client.readGroupUsersAfter, newVars(pageLimit(client.pageLimit), gqlID(input.ID))); err != nil {
-		return nil, err //nolint
+	if err := client.fetchGroupInternalResources(ctx, response.Entity); err != nil {
+		return nil, err
 	}
 
 	group := response.Entity.ToModel()
@@ -159,6 +216,7 @@ func (client *Client) DeleteGroupUsers(ctx context.Context, groupID string, user
 		gqlID(groupID),
 		gqlIDs(userIDs, "removedUserIds"),
 		cursor(query.CursorUsers),
+		cursor(query.CursorResources),
 		pageLimit(client.pageLimit),
 	)
 
@@ -170,6 +228,7 @@ func (client *Client) DeleteGroupUsers(ctx context.Context, groupID string, user
 func (client *Client) readGroupUsersAfter(ctx context.Context, variables map[string]interface{}, cursor string) (*query.PaginatedResource[*query.UserEdge], error) {
 	opr := resourceGroup.read()
 
+	gqlNullable("", query.CursorResources)(variables)
 	variables[query.CursorUsers] = cursor
 	resourceID := fmt.Sprintf("%v", variables["id"])
 
@@ -180,3 +239,43 @@ func (client *Client) readGroupUsersAfter(ctx context.Context, variables map[str
 
 	return &response.Group.Users.PaginatedResource, nil
 }
+
+// ReadGroupResources reads the IDs of the resources the group has access to.
+func (client *Client) ReadGroupResources(ctx context.Context, groupID string) ([]string, error) {
+	opr := resourceGroup.read()
+
+	if groupID == "" {
+		return nil, opr.apiError(ErrGraphqlIDIsEmpty)
+	}
+
+	variables := newVars(
+		gqlID(groupID),
+		cursor(query.CursorResources),
+		pageLimit(client.pageLimit),
+	)
+
+	response := query.ReadGroupResources{}
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readGroupResources"), attr{id: groupID}); err != nil {
+		return nil, err
+	}
+
+	if err := response.Group.Resources.FetchPages(ctx, client.readGroupResourcesAfter, variables); err != nil {
+		return nil, err //nolint
+	}
+
+	return response.ToModel(), nil
+}
+
+func (client *Client) readGroupResourcesAfter(ctx context.Context, variables map[string]interface{}, cursor string) (*query.PaginatedResource[*query.GqlResourceIDEdge], error) {
+	opr := resourceGroup.read()
+
+	variables[query.CursorResources] = cursor
+	groupID := fmt.Sprintf("%v", variables["id"])
+
+	response := query.ReadGroupResources{}
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readGroupResources"), attr{id: groupID}); err != nil {
+		return nil, err
+	}
+
+	return &response.Group.Resources.PaginatedResource, nil
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// LogSubsystem is the tflog subsystem the client logs the API calls to.
+	LogSubsystem = "client"
+
+	// EnvLogLevelPrefix followed by the subsystem name in upper case, without the provider prefix,
+	// sets the level of a subsystem logger, e.g. TF_LOG_PROVIDER_SOC2BD_CLIENT or TF_LOG_PROVIDER_SOC2BD_GROUP.
+	EnvLogLevelPrefix = "TF_LOG_PROVIDER_SOC2BD"
+
+	providerPrefix = "soc2bd_"
+)
+
+// The fields set on the client and resource log entries.
+const (
+	LogFieldOperation     = "operation"
+	LogFieldEntityID      = "entity_id"
+	LogFieldCorrelationID = "correlation_id"
+	LogFieldRetry         = "retry"
+	LogFieldURL           = "url"
+	LogFieldStatusCode    = "status_code"
+)
+
+// SensitiveLogFields are the field keys whose values are always masked in the logs.
+//
+//nolint:gochecknoglobals
+var SensitiveLogFields = []string{
+	"token",
+	"access_token",
+	"accessToken",
+	"refresh_token",
+	"refreshToken",
+	"api_token",
+	"apiToken",
+	headerAuthorization,
+	headerAPIKey,
+}
+
+// NewLogSubsystem returns the context with a logger for the subsystem that masks the sensitive fields
+// and every occurrence of the given secret values.
+func NewLogSubsystem(ctx context.Context, subsystem string, secrets ...string) context.Context {
+	ctx = tflog.NewSubsystem(ctx, subsystem, tflog.WithLevelFromEnv(EnvLogLevelPrefix, strings.TrimPrefix(subsystem, providerPrefix)))
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, subsystem, SensitiveLogFields...)
+
+	return MaskLogValues(ctx, subsystem, secrets...)
+}
+
+// MaskLogValues masks every occurrence of the secret values in the messages and fields logged to the subsystem.
+func MaskLogValues(ctx context.Context, subsystem string, secrets ...string) context.Context {
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, subsystem, secret)
+		ctx = tflog.SubsystemMaskMessageStrings(ctx, subsystem, secret)
+	}
+
+	return ctx
+}
+
+// CorrelationID returns the ID sent in the X-Correlation-Id header of every request made by the client.
+func (client *Client) CorrelationID() string {
+	return client.correlationID
+}
+
+// logContext returns the context with the client logger tagged with the operation and the entity it acts on.
+func (client *Client) logContext(ctx context.Context, opr string, attrs ...attr) context.Context {
+	ctx = NewLogSubsystem(ctx, LogSubsystem, client.apiToken())
+	ctx = tflog.SubsystemSetField(ctx, LogSubsystem, LogFieldCorrelationID, client.correlationID)
+
+	if opr != "" {
+		ctx = tflog.SubsystemSetField(ctx, LogSubsystem, LogFieldOperation, opr)
+	}
+
+	for _, atr := range attrs {
+		if atr.id != "" {
+			return tflog.SubsystemSetField(ctx, LogSubsystem, LogFieldEntityID, atr.id)
+		}
+	}
+
+	return ctx
+}
+
+// logVariables returns the GraphQL variables as log fields named after them, with the values JSON encoded,
+// so the sensitive field keys and the secret values are masked like any other field.
+func logVariables(variables map[string]any) map[string]interface{} {
+	fields := make(map[string]interface{}, len(variables))
+
+	for name, value := range variables {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			fields[name] = fmt.Sprintf("%v", value)
+
+			continue
+		}
+
+		var str string
+		if json.Unmarshal(encoded, &str) == nil {
+			fields[name] = str
+
+			continue
+		}
+
+		fields[name] = string(encoded)
+	}
+
+	return fields
+}
+
+func (client *Client) apiToken() string {
+	if client.transport == nil {
+		return os.Getenv(EnvAPIToken)
+	}
+
+	return client.transport.currentToken()
+}
+
+func (client *Client) logError(ctx context.Context, err *APIError) *APIError {
+	tflog.SubsystemError(ctx, LogSubsystem, "API call failed", map[string]interface{}{"error": err.Error()})
+
+	return err
+}
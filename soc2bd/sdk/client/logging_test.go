@@ -0,0 +1,115 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/hasura/go-graphql-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func findLogEntry(entries []map[string]interface{}, message string) map[string]interface{} {
+	for _, entry := range entries {
+		if entry["@message"] == message {
+			return entry
+		}
+	}
+
+	return nil
+}
+
+func TestClientLogging(t *testing.T) {
+	srv, _ := newThrottlingServer(1, `{"errors": [{"message": "invalid api token secret-api-token"}]}`)
+	defer srv.Close()
+
+	var output bytes.Buffer
+
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	client := NewClient(WithURL(srv.URL), WithAPIToken("secret-api-token"), WithHTTPMaxRetry(1))
+
+	_, err := client.ReadUser(ctx, "user-1")
+	require.Error(t, err)
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+
+	call := findLogEntry(entries, "Calling API query")
+	require.NotNil(t, call)
+	assert.Equal(t, "debug", call["@level"])
+	assert.Equal(t, "user-1", call["id"])
+
+	retry := findLogEntry(entries, "Retrying request")
+	require.NotNil(t, retry)
+	assert.Equal(t, "provider.client", retry["@module"])
+	assert.EqualValues(t, 1, retry[LogFieldRetry])
+	assert.Equal(t, "readUser", retry[LogFieldOperation])
+	assert.Equal(t, "user-1", retry[LogFieldEntityID])
+	assert.Equal(t, client.CorrelationID(), retry[LogFieldCorrelationID])
+
+	failed := findLogEntry(entries, "API call failed")
+	require.NotNil(t, failed)
+	assert.Contains(t, failed["error"], "invalid api token ***")
+	assert.NotContains(t, output.String(), "secret-api-token")
+}
+
+func TestLoggingMasksSensitiveFields(t *testing.T) {
+	var output bytes.Buffer
+
+	ctx := NewLogSubsystem(tflogtest.RootLogger(context.Background(), &output), LogSubsystem, "service-key-token")
+
+	tflog.SubsystemInfo(ctx, LogSubsystem, "Logging service-key-token", map[string]interface{}{
+		headerAPIKey:    "api-key",
+		"refresh_token": "refresh",
+		"access_token":  "access",
+		"token":         "token",
+		"name":          "visible",
+	})
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	assert.Equal(t, map[string]interface{}{
+		"@level":        "info",
+		"@message":      "Logging ***",
+		"@module":       "provider.client",
+		headerAPIKey:    "***",
+		"refresh_token": "***",
+		"access_token":  "***",
+		"token":         "***",
+		"name":          "visible",
+	}, entries[0])
+}
+
+func TestLogVariablesMasksSecrets(t *testing.T) {
+	var output bytes.Buffer
+
+	ctx := NewLogSubsystem(tflogtest.RootLogger(context.Background(), &output), LogSubsystem, "secret-value")
+
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Calling API mutation", logVariables(map[string]any{
+		"name":         graphql.String("visible"),
+		"refreshToken": graphql.String("refresh"),
+		"description":  "contains secret-value",
+		"pageLimit":    graphql.Int(10),
+		"ids":          []graphql.ID{"id-1", "id-2"},
+	}))
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	assert.Equal(t, map[string]interface{}{
+		"@level":       "debug",
+		"@message":     "Calling API mutation",
+		"@module":      "provider.client",
+		"name":         "visible",
+		"refreshToken": "***",
+		"description":  "contains ***",
+		"pageLimit":    "10",
+		"ids":          `["id-1","id-2"]`,
+	}, entries[0])
+}
@@ -68,6 +68,9 @@ func (r resource) revoke() operation {
 	}
 }
 
+// idAll is the id reported in errors of queries reading all the entities.
+const idAll = "All"
+
 type attr struct {
 	id   string
 	name string
@@ -0,0 +1,178 @@
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk"
+)
+
+const (
+	DefaultURL          = "soc2bd.com"
+	DefaultHTTPTimeout  = 10 * time.Second
+	DefaultHTTPMaxRetry = 10
+)
+
+type config struct {
+	url                string
+	network            string
+	apiToken           string
+	httpTimeout        time.Duration
+	httpMaxRetry       int
+	userAgent          string
+	pageLimit          int
+	rateLimit          float64
+	rateLimitBurst     int
+	caCertPEM          string
+	caCertFile         string
+	clientCertPEM      string
+	clientKeyPEM       string
+	proxyURL           string
+	noProxy            string
+	insecureSkipVerify bool
+	tokens             TokenSource
+}
+
+// Option configures the Client created with NewClient.
+type Option func(cfg *config)
+
+func newConfig(opts ...Option) *config {
+	cfg := &config{
+		url:          DefaultURL,
+		httpTimeout:  DefaultHTTPTimeout,
+		httpMaxRetry: DefaultHTTPMaxRetry,
+		userAgent:    DefaultUserAgent(),
+		pageLimit:    getPageLimit(),
+	}
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return cfg
+}
+
+// DefaultUserAgent is the User-Agent sent by the SDK when not overridden.
+func DefaultUserAgent() string {
+	return fmt.Sprintf("Soc2bdSDK/%s", sdk.Version)
+}
+
+// WithNetwork sets the Soc2bd network slug, e.g. `autoco` for autoco.soc2bd.com.
+func WithNetwork(network string) Option {
+	return func(cfg *config) {
+		cfg.network = network
+	}
+}
+
+// WithURL sets the Soc2bd domain, defaults to soc2bd.com.
+// A URL with an explicit scheme is used as is, ignoring the network.
+func WithURL(url string) Option {
+	return func(cfg *config) {
+		if url != "" {
+			cfg.url = url
+		}
+	}
+}
+
+// WithAPIToken sets the API token, by default it's read from the SOC2BD_API_TOKEN environment variable.
+func WithAPIToken(apiToken string) Option {
+	return func(cfg *config) {
+		cfg.apiToken = apiToken
+	}
+}
+
+// WithTokenSource sets the source of the API token, e.g. NewFileTokenSource, it takes precedence over WithAPIToken.
+func WithTokenSource(tokens TokenSource) Option {
+	return func(cfg *config) {
+		cfg.tokens = tokens
+	}
+}
+
+func (cfg *config) tokenSource() TokenSource {
+	if cfg.tokens != nil {
+		return cfg.tokens
+	}
+
+	return staticTokenSource{apiToken: cfg.apiToken}
+}
+
+// WithHTTPTimeout sets the timeout of a single http request.
+func WithHTTPTimeout(timeout time.Duration) Option {
+	return func(cfg *config) {
+		cfg.httpTimeout = timeout
+	}
+}
+
+// WithHTTPMaxRetry sets the retry limit of the http requests.
+func WithHTTPMaxRetry(maxRetry int) Option {
+	return func(cfg *config) {
+		cfg.httpMaxRetry = maxRetry
+	}
+}
+
+// WithUserAgent overrides the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(cfg *config) {
+		if userAgent != "" {
+			cfg.userAgent = userAgent
+		}
+	}
+}
+
+// WithPageLimit sets the page size used by paginated queries,
+// by default it's read from the SOC2BD_PAGE_LIMIT environment variable.
+func WithPageLimit(limit int) Option {
+	return func(cfg *config) {
+		if limit > 0 {
+			cfg.pageLimit = limit
+		}
+	}
+}
+
+// WithRateLimit limits the number of requests sent per second, with the given burst.
+// A non positive rate disables the limit, which is the default.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(cfg *config) {
+		cfg.rateLimit = requestsPerSecond
+		cfg.rateLimitBurst = burst
+	}
+}
+
+// WithCACert adds the PEM encoded CA certificates to the system ones to verify the API server certificate,
+// e.g. the certificate of an inspecting proxy with a private CA.
+func WithCACert(caCertPEM string) Option {
+	return func(cfg *config) {
+		cfg.caCertPEM = caCertPEM
+	}
+}
+
+// WithCACertFile adds the CA certificates of the PEM file to the system ones to verify the API server certificate.
+func WithCACertFile(path string) Option {
+	return func(cfg *config) {
+		cfg.caCertFile = path
+	}
+}
+
+// WithClientCertificate sets the PEM encoded certificate and private key presented for mutual TLS.
+func WithClientCertificate(certPEM, keyPEM string) Option {
+	return func(cfg *config) {
+		cfg.clientCertPEM = certPEM
+		cfg.clientKeyPEM = keyPEM
+	}
+}
+
+// WithProxy sends the requests through the proxy, by default the HTTPS_PROXY environment variable is used.
+// The hosts in the comma separated no proxy list are called directly.
+func WithProxy(proxyURL, noProxy string) Option {
+	return func(cfg *config) {
+		cfg.proxyURL = proxyURL
+		cfg.noProxy = noProxy
+	}
+}
+
+// WithInsecureSkipVerify disables the verification of the API server certificate, for lab use only.
+func WithInsecureSkipVerify(skip bool) Option {
+	return func(cfg *config) {
+		cfg.insecureSkipVerify = skip
+	}
+}
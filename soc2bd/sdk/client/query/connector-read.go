@@ -1,7 +1,7 @@
 package query
 
 import (
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/hasura/go-graphql-client"
 )
 
@@ -15,6 +15,12 @@ type gqlConnector struct {
 		ID graphql.ID
 	}
 	HasStatusNotificationsEnabled bool
+	State                         string
+	LastHeartbeatAt               string
+	Version                       string
+	Hostname                      string
+	PublicIP                      string   `graphql:"publicIP"`
+	PrivateIPs                    []string `graphql:"privateIPs"`
 }
 
 func (q ReadConnector) IsEmpty() bool {
@@ -35,5 +41,11 @@ func (c gqlConnector) ToModel() *model.Connector {
 		Name:                 c.Name,
 		NetworkID:            string(c.RemoteNetwork.ID),
 		StatusUpdatesEnabled: &c.HasStatusNotificationsEnabled,
+		State:                c.State,
+		LastHeartbeatAt:      c.LastHeartbeatAt,
+		Version:              c.Version,
+		Hostname:             c.Hostname,
+		PublicIP:             c.PublicIP,
+		PrivateIPs:           c.PrivateIPs,
 	}
 }
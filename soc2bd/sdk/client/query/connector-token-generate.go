@@ -1,7 +1,7 @@
 package query
 
 import (
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 )
 
 type GenerateConnectorTokens struct {
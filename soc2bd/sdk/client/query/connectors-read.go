@@ -1,8 +1,8 @@
 package query
 
 import (
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/utils"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 )
 
 const CursorConnectors = "connectorsEndCursor"
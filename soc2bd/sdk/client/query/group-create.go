@@ -1,13 +1,13 @@
 package query
 
-import "github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+import "github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 
 type CreateGroup struct {
 	GroupEntityResponse `graphql:"groupCreate(name: $name, userIds: $userIds, securityPolicyId: $securityPolicyId)"`
 }
 
 type GroupEntityResponse struct {
-	Entity *gqlGroup
+	Entity *GqlGroup
 	OkError
 }
 
@@ -1,23 +1,24 @@
 package query
 
 import (
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/utils"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 )
 
 type ReadGroup struct {
-	Group *gqlGroup `graphql:"group(id: $id)"`
+	Group *GqlGroup `graphql:"group(id: $id)"`
 }
 
-type gqlGroup struct {
+type GqlGroup struct {
 	IDName
 	IsActive       bool
 	Type           string
-	Users          Users `graphql:"users(after: $usersEndCursor, first: $pageLimit)"`
+	Users          Users          `graphql:"users(after: $usersEndCursor, first: $pageLimit)"`
+	Resources      gqlResourceIDs `graphql:"resources(after: $resourcesEndCursor, first: $pageLimit)"`
 	SecurityPolicy gqlSecurityPolicy
 }
 
-func (g gqlGroup) ToModel() *model.Group {
+func (g GqlGroup) ToModel() *model.Group {
 	return &model.Group{
 		ID:       string(g.ID),
 		Name:     g.Name,
@@ -26,6 +27,7 @@ func (g gqlGroup) ToModel() *model.Group {
 		Users: utils.Map[*UserEdge, string](g.Users.Edges, func(edge *UserEdge) string {
 			return string(edge.Node.ID)
 		}),
+		Resources:        g.Resources.listIDs(),
 		SecurityPolicyID: string(g.SecurityPolicy.ID),
 	}
 }
@@ -0,0 +1,21 @@
+package query
+
+type ReadGroupResources struct {
+	Group *gqlGroupResources `graphql:"group(id: $id)"`
+}
+
+type gqlGroupResources struct {
+	Resources gqlResourceIDs `graphql:"resources(after: $resourcesEndCursor, first: $pageLimit)"`
+}
+
+func (q ReadGroupResources) IsEmpty() bool {
+	return q.Group == nil
+}
+
+func (q ReadGroupResources) ToModel() []string {
+	if q.Group == nil {
+		return nil
+	}
+
+	return q.Group.Resources.listIDs()
+}
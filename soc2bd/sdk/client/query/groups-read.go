@@ -1,8 +1,8 @@
 package query
 
 import (
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/utils"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 )
 
 const CursorGroups = "groupsEndCursor"
@@ -20,7 +20,7 @@ type Groups struct {
 }
 
 type GroupEdge struct {
-	Node *gqlGroup
+	Node *GqlGroup
 }
 
 func (u Groups) ToModel() []*model.Group {
@@ -36,7 +36,10 @@ type GroupFilterInput struct {
 }
 
 type StringFilterOperationInput struct {
-	Eq string `json:"eq"`
+	Eq         string `json:"eq,omitempty"`
+	StartsWith string `json:"startsWith,omitempty"`
+	EndsWith   string `json:"endsWith,omitempty"`
+	Regexp     string `json:"regexp,omitempty"`
 }
 
 type GroupTypeFilterOperatorInput struct {
@@ -0,0 +1,45 @@
+package query
+
+import (
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/utils"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+)
+
+type ReadShallowGroups struct {
+	ShallowGroups `graphql:"groups(filter: $filter, after: $groupsEndCursor, first: $pageLimit)"`
+}
+
+func (q ReadShallowGroups) IsEmpty() bool {
+	return len(q.Edges) == 0
+}
+
+type ShallowGroups struct {
+	PaginatedResource[*ShallowGroupEdge]
+}
+
+type ShallowGroupEdge struct {
+	Node *gqlShallowGroup
+}
+
+type gqlShallowGroup struct {
+	IDName
+	IsActive       bool
+	Type           string
+	SecurityPolicy gqlSecurityPolicy
+}
+
+func (g gqlShallowGroup) ToModel() *model.Group {
+	return &model.Group{
+		ID:               string(g.ID),
+		Name:             g.Name,
+		Type:             g.Type,
+		IsActive:         g.IsActive,
+		SecurityPolicyID: string(g.SecurityPolicy.ID),
+	}
+}
+
+func (g ShallowGroups) ToModel() []*model.Group {
+	return utils.Map[*ShallowGroupEdge, *model.Group](g.Edges, func(edge *ShallowGroupEdge) *model.Group {
+		return edge.Node.ToModel()
+	})
+}
@@ -0,0 +1,69 @@
+package query
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const PageLimit = "pageLimit"
+
+const (
+	// LogSubsystem is the tflog subsystem the pages fetched are logged to.
+	LogSubsystem = "pagination"
+
+	envLogLevelPrefix = "TF_LOG_PROVIDER_SOC2BD"
+)
+
+type PageInfo struct {
+	EndCursor   string
+	HasNextPage bool
+}
+
+type PaginatedResource[E any] struct {
+	PageInfo PageInfo
+	Edges    []E
+}
+
+type NextPageFunc[E any] func(ctx context.Context, variables map[string]interface{}, cursor string) (*PaginatedResource[E], error)
+
+func (r *PaginatedResource[E]) FetchPages(ctx context.Context, fetchNextPage NextPageFunc[E], variables map[string]interface{}) error {
+	if r == nil {
+		return nil
+	}
+
+	ctx = tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithLevelFromEnv(envLogLevelPrefix, LogSubsystem))
+
+	pages := 1
+	page := r.PageInfo
+
+	for page.HasNextPage {
+		tflog.SubsystemDebug(ctx, LogSubsystem, "Fetching next page", map[string]interface{}{
+			"cursor": page.EndCursor,
+			"page":   pages + 1,
+		})
+
+		next, err := fetchNextPage(ctx, variables, page.EndCursor)
+		if err != nil {
+			tflog.SubsystemError(ctx, LogSubsystem, "Failed to fetch page", map[string]interface{}{
+				"page":  pages + 1,
+				"error": err.Error(),
+			})
+
+			return err
+		}
+
+		pages++
+		r.Edges = append(r.Edges, next.Edges...)
+		page = next.PageInfo
+	}
+
+	if pages > 1 {
+		tflog.SubsystemDebug(ctx, LogSubsystem, "Fetched all pages", map[string]interface{}{
+			"pages": pages,
+			"edges": len(r.Edges),
+		})
+	}
+
+	return nil
+}
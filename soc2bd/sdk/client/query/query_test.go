@@ -6,7 +6,7 @@ import (
 	"testing"
 	"time"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/hasura/go-graphql-client"
 	"github.com/stretchr/testify/assert"
 )
@@ -68,6 +68,39 @@ func TestReadConnectorQueryToModel(t *testing.T) {
 				StatusUpdatesEnabled: &boolTrue,
 			},
 		},
+		{
+			query: ReadConnector{
+				Connector: &gqlConnector{
+					IDName: IDName{
+						ID:   "connector-id",
+						Name: "connector-name",
+					},
+					RemoteNetwork: struct {
+						ID graphql.ID
+					}{
+						ID: "connector-network-id",
+					},
+					State:           model.ConnectorStateAlive,
+					LastHeartbeatAt: "2026-01-02T03:04:05Z",
+					Version:         "1.60.0",
+					Hostname:        "connector-host",
+					PublicIP:        "203.0.113.10",
+					PrivateIPs:      []string{"10.0.0.10"},
+				},
+			},
+			expected: &model.Connector{
+				ID:                   "connector-id",
+				Name:                 "connector-name",
+				NetworkID:            "connector-network-id",
+				StatusUpdatesEnabled: &boolFalse,
+				State:                model.ConnectorStateAlive,
+				LastHeartbeatAt:      "2026-01-02T03:04:05Z",
+				Version:              "1.60.0",
+				Hostname:             "connector-host",
+				PublicIP:             "203.0.113.10",
+				PrivateIPs:           []string{"10.0.0.10"},
+			},
+		},
 	}
 
 	for n, c := range cases {
@@ -228,6 +261,7 @@ func TestReadServiceAccountKeyToModel(t *testing.T) {
 				Name:           "service key name",
 				Service:        "service-account-id",
 				ExpirationTime: 1,
+				ExpiresAt:      today.Format(time.RFC3339),
 				Status:         "OK",
 			},
 		},
@@ -319,6 +353,7 @@ func TestUpdateServiceAccountKeyToModel(t *testing.T) {
 				Name:           "service key name",
 				Service:        "service-account-id",
 				ExpirationTime: 1,
+				ExpiresAt:      today.Format(time.RFC3339),
 				Status:         "OK",
 			},
 		},
@@ -417,6 +452,7 @@ func TestCreateServiceAccountKeyToModel(t *testing.T) {
 				Name:           "service key name",
 				Service:        "service-account-id",
 				ExpirationTime: 1,
+				ExpiresAt:      today.Format(time.RFC3339),
 				Status:         "OK",
 				Token:          "token",
 			},
@@ -657,7 +693,7 @@ func TestCreateGroupToModel(t *testing.T) {
 		{
 			query: CreateGroup{
 				GroupEntityResponse{
-					Entity: &gqlGroup{
+					Entity: &GqlGroup{
 						IDName: IDName{
 							ID:   "group-id",
 							Name: "group-name",
@@ -668,11 +704,12 @@ func TestCreateGroupToModel(t *testing.T) {
 				},
 			},
 			expected: &model.Group{
-				ID:       "group-id",
-				Name:     "group-name",
-				IsActive: true,
-				Type:     "MANUAL",
-				Users:    []string{},
+				ID:        "group-id",
+				Name:      "group-name",
+				IsActive:  true,
+				Type:      "MANUAL",
+				Users:     []string{},
+				Resources: []string{},
 			},
 		},
 	}
@@ -695,21 +732,29 @@ func TestReadGroupToModel(t *testing.T) {
 		},
 		{
 			query: ReadGroup{
-				Group: &gqlGroup{
+				Group: &GqlGroup{
 					IDName: IDName{
 						ID:   "group-id",
 						Name: "group-name",
 					},
 					IsActive: true,
 					Type:     "MANUAL",
+					Resources: gqlResourceIDs{
+						PaginatedResource: PaginatedResource[*GqlResourceIDEdge]{
+							Edges: []*GqlResourceIDEdge{
+								{Node: &gqlResourceID{ID: "resource-1"}},
+							},
+						},
+					},
 				},
 			},
 			expected: &model.Group{
-				ID:       "group-id",
-				Name:     "group-name",
-				IsActive: true,
-				Type:     "MANUAL",
-				Users:    []string{},
+				ID:        "group-id",
+				Name:      "group-name",
+				IsActive:  true,
+				Type:      "MANUAL",
+				Users:     []string{},
+				Resources: []string{"resource-1"},
 			},
 		},
 	}
@@ -939,6 +984,88 @@ func TestBuildGroupsFilter(t *testing.T) {
 	}
 }
 
+func TestNewResourceFilterInput(t *testing.T) {
+	name := "name"
+	prefix := "prefix"
+	suffix := "suffix"
+	pattern := "^web-.*$"
+	networkID := "network-id"
+
+	testCases := []struct {
+		filter   *model.ResourcesFilter
+		expected *ResourceFilterInput
+	}{
+		{
+			filter:   nil,
+			expected: nil,
+		},
+		{
+			filter:   &model.ResourcesFilter{RemoteNetworkID: &networkID},
+			expected: nil,
+		},
+		{
+			filter: &model.ResourcesFilter{Name: &name},
+			expected: &ResourceFilterInput{
+				Name: &StringFilterOperationInput{Eq: name},
+			},
+		},
+		{
+			filter: &model.ResourcesFilter{NamePrefix: &prefix, NameSuffix: &suffix, NameRegexp: &pattern, RemoteNetworkID: &networkID},
+			expected: &ResourceFilterInput{
+				Name: &StringFilterOperationInput{StartsWith: prefix, EndsWith: suffix, Regexp: pattern},
+			},
+		},
+	}
+
+	for n, td := range testCases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, td.expected, NewResourceFilterInput(td.filter))
+		})
+	}
+}
+
+func TestNewUserFilterInput(t *testing.T) {
+	role := model.UserRoleDevops
+	state := model.UserStateActive
+	domain := "example.com"
+	pattern := "^ops-.*"
+	firstName := "John"
+
+	testCases := []struct {
+		filter   *model.UsersFilter
+		expected *UserFilterInput
+	}{
+		{
+			filter:   nil,
+			expected: nil,
+		},
+		{
+			filter:   &model.UsersFilter{},
+			expected: nil,
+		},
+		{
+			filter: &model.UsersFilter{Role: &role, State: &state, FirstName: &firstName},
+			expected: &UserFilterInput{
+				FirstName: &StringFilterOperationInput{Eq: firstName},
+				Role:      &UserRoleFilterOperatorInput{In: []string{role}},
+				State:     &UserStateFilterOperatorInput{In: []string{state}},
+			},
+		},
+		{
+			filter: &model.UsersFilter{EmailDomain: &domain, EmailRegexp: &pattern},
+			expected: &UserFilterInput{
+				Email: &StringFilterOperationInput{EndsWith: "@" + domain, Regexp: pattern},
+			},
+		},
+	}
+
+	for n, td := range testCases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, td.expected, NewUserFilterInput(td.filter))
+		})
+	}
+}
+
 func TestPortsRangeToModel(t *testing.T) {
 	cases := []struct {
 		ports    []*PortRange
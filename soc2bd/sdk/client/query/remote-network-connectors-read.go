@@ -0,0 +1,23 @@
+package query
+
+import "github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+
+type ReadRemoteNetworkConnectors struct {
+	RemoteNetwork *gqlRemoteNetworkConnectors `graphql:"remoteNetwork(id: $id)"`
+}
+
+type gqlRemoteNetworkConnectors struct {
+	Connectors Connectors `graphql:"connectors(after: $connectorsEndCursor, first: $pageLimit)"`
+}
+
+func (q ReadRemoteNetworkConnectors) IsEmpty() bool {
+	return q.RemoteNetwork == nil
+}
+
+func (q ReadRemoteNetworkConnectors) ToModel() []*model.Connector {
+	if q.RemoteNetwork == nil {
+		return nil
+	}
+
+	return q.RemoteNetwork.Connectors.ToModel()
+}
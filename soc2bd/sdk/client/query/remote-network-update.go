@@ -1,6 +1,6 @@
 package query
 
-import "github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+import "github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 
 type UpdateRemoteNetwork struct {
 	RemoteNetworkEntityResponse `graphql:"remoteNetworkUpdate(id: $id, name: $name, location: $location)"`
@@ -0,0 +1,42 @@
+package query
+
+import "github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+
+type ReadResourcesByFilter struct {
+	Resources `graphql:"resources(filter: $filter, after: $resourcesEndCursor, first: $pageLimit)"`
+}
+
+func (q ReadResourcesByFilter) IsEmpty() bool {
+	return len(q.Edges) == 0
+}
+
+type ResourceFilterInput struct {
+	Name *StringFilterOperationInput `json:"name"`
+}
+
+// NewResourceFilterInput returns the filters the API supports, the others are applied by the client.
+func NewResourceFilterInput(input *model.ResourcesFilter) *ResourceFilterInput {
+	if !input.HasNameFilter() {
+		return nil
+	}
+
+	name := &StringFilterOperationInput{}
+
+	if input.Name != nil {
+		name.Eq = *input.Name
+	}
+
+	if input.NamePrefix != nil {
+		name.StartsWith = *input.NamePrefix
+	}
+
+	if input.NameSuffix != nil {
+		name.EndsWith = *input.NameSuffix
+	}
+
+	if input.NameRegexp != nil {
+		name.Regexp = *input.NameRegexp
+	}
+
+	return &ResourceFilterInput{Name: name}
+}
@@ -0,0 +1,24 @@
+package query
+
+import "github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+
+type CreateSecurityPolicy struct {
+	SecurityPolicyEntityResponse `graphql:"securityPolicyCreate(name: $name, requireMfa: $requireMfa, mfaMethods: $mfaMethods, sessionLifetimeHours: $sessionLifetimeHours, devicePosture: $devicePosture, groupIds: $groupIds)"`
+}
+
+type SecurityPolicyEntityResponse struct {
+	Entity *gqlSecurityPolicyRules
+	OkError
+}
+
+func (q SecurityPolicyEntityResponse) ToModel() *model.SecurityPolicy {
+	if q.Entity == nil {
+		return nil
+	}
+
+	return q.Entity.ToModel()
+}
+
+func (q CreateSecurityPolicy) IsEmpty() bool {
+	return q.Entity == nil
+}
@@ -0,0 +1,9 @@
+package query
+
+type DeleteSecurityPolicy struct {
+	OkError `graphql:"securityPolicyDelete(id: $id)" json:"securityPolicyDelete"`
+}
+
+func (q DeleteSecurityPolicy) IsEmpty() bool {
+	return false
+}
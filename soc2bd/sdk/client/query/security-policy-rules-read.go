@@ -0,0 +1,79 @@
+package query
+
+import (
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/utils"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+	"github.com/hasura/go-graphql-client"
+)
+
+type ReadSecurityPolicyRules struct {
+	SecurityPolicy *gqlSecurityPolicyRules `graphql:"securityPolicy(id: $id)"`
+}
+
+func (q ReadSecurityPolicyRules) IsEmpty() bool {
+	return q.SecurityPolicy == nil
+}
+
+func (q ReadSecurityPolicyRules) ToModel() *model.SecurityPolicy {
+	if q.SecurityPolicy == nil {
+		return nil
+	}
+
+	return q.SecurityPolicy.ToModel()
+}
+
+type gqlSecurityPolicyRules struct {
+	IDName
+	RequireMfa           bool
+	MfaMethods           []string
+	SessionLifetimeHours int
+	DevicePosture        *gqlDevicePosture
+	Groups               GqlGroupIDs `graphql:"groups(after: $groupsEndCursor, first: $pageLimit)"`
+}
+
+type gqlDevicePosture struct {
+	RequireScreenLock       bool
+	RequireDiskEncryption   bool
+	RequireFirewall         bool
+	AllowedOperatingSystems []string
+}
+
+func (q *gqlSecurityPolicyRules) ToModel() *model.SecurityPolicy {
+	policy := &model.SecurityPolicy{
+		ID:                   string(q.ID),
+		Name:                 q.Name,
+		RequireMFA:           q.RequireMfa,
+		MFAMethods:           q.MfaMethods,
+		SessionLifetimeHours: q.SessionLifetimeHours,
+		Groups:               q.Groups.listIDs(),
+	}
+
+	if q.DevicePosture != nil {
+		policy.DevicePosture = &model.DevicePosture{
+			RequireScreenLock:       q.DevicePosture.RequireScreenLock,
+			RequireDiskEncryption:   q.DevicePosture.RequireDiskEncryption,
+			RequireFirewall:         q.DevicePosture.RequireFirewall,
+			AllowedOperatingSystems: q.DevicePosture.AllowedOperatingSystems,
+		}
+	}
+
+	return policy
+}
+
+type GqlGroupIDs struct {
+	PaginatedResource[*GqlGroupIDEdge]
+}
+
+func (q GqlGroupIDs) listIDs() []string {
+	return utils.Map[*GqlGroupIDEdge, string](q.Edges, func(edge *GqlGroupIDEdge) string {
+		return string(edge.Node.ID)
+	})
+}
+
+type GqlGroupIDEdge struct {
+	Node *gqlGroupID
+}
+
+type gqlGroupID struct {
+	ID graphql.ID
+}
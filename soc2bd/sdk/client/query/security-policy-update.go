@@ -0,0 +1,17 @@
+package query
+
+type UpdateSecurityPolicy struct {
+	SecurityPolicyEntityResponse `graphql:"securityPolicyUpdate(id: $id, name: $name, requireMfa: $requireMfa, mfaMethods: $mfaMethods, sessionLifetimeHours: $sessionLifetimeHours, devicePosture: $devicePosture, addedGroupIds: $addedGroupIds)"`
+}
+
+type UpdateSecurityPolicyRemoveGroups struct {
+	SecurityPolicyEntityResponse `graphql:"securityPolicyUpdate(id: $id, removedGroupIds: $removedGroupIds)"`
+}
+
+func (q UpdateSecurityPolicy) IsEmpty() bool {
+	return q.Entity == nil
+}
+
+func (q UpdateSecurityPolicyRemoveGroups) IsEmpty() bool {
+	return q.Entity == nil
+}
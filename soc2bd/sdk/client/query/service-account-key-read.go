@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 )
 
 type ReadServiceAccountKey struct {
@@ -41,6 +41,7 @@ func (q gqlServiceKey) ToModel() (*model.ServiceKey, error) {
 		Name:           q.Name,
 		Service:        string(q.ServiceAccount.ID),
 		ExpirationTime: expirationTime,
+		ExpiresAt:      q.ExpiresAt,
 		Status:         q.Status,
 	}, nil
 }
@@ -1,6 +1,6 @@
 package query
 
-import "github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+import "github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 
 type ReadShallowServiceAccount struct {
 	ServiceAccount *gqlServiceAccount `graphql:"serviceAccount(id: $id)"`
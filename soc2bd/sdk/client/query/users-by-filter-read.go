@@ -0,0 +1,114 @@
+package query
+
+import (
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/utils"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+)
+
+type ReadUsersByFilter struct {
+	UsersWithGroups `graphql:"users(filter: $filter, after: $usersEndCursor, first: $pageLimit)"`
+}
+
+func (q ReadUsersByFilter) IsEmpty() bool {
+	return len(q.Edges) == 0
+}
+
+type UsersWithGroups struct {
+	PaginatedResource[*UserWithGroupsEdge]
+}
+
+type UserWithGroupsEdge struct {
+	Node *gqlUserWithGroups
+}
+
+type gqlUserWithGroups struct {
+	gqlUser
+	Groups GqlGroupIDs `graphql:"groups(after: $groupsEndCursor, first: $pageLimit)"`
+}
+
+func (u gqlUserWithGroups) ToModel() *model.User {
+	user := u.gqlUser.ToModel()
+	user.Groups = u.Groups.listIDs()
+
+	return user
+}
+
+func (u UsersWithGroups) ToModel() []*model.User {
+	return utils.Map[*UserWithGroupsEdge, *model.User](u.Edges, func(edge *UserWithGroupsEdge) *model.User {
+		return edge.Node.ToModel()
+	})
+}
+
+type ReadUserGroups struct {
+	User *struct {
+		Groups GqlGroupIDs `graphql:"groups(after: $groupsEndCursor, first: $pageLimit)"`
+	} `graphql:"user(id: $id)"`
+}
+
+func (q ReadUserGroups) IsEmpty() bool {
+	return q.User == nil
+}
+
+type UserFilterInput struct {
+	FirstName *StringFilterOperationInput   `json:"firstName"`
+	LastName  *StringFilterOperationInput   `json:"lastName"`
+	Email     *StringFilterOperationInput   `json:"email"`
+	Role      *UserRoleFilterOperatorInput  `json:"role"`
+	Type      *UserTypeFilterOperatorInput  `json:"type"`
+	State     *UserStateFilterOperatorInput `json:"state"`
+}
+
+type UserRoleFilterOperatorInput struct {
+	In []string `json:"in"`
+}
+
+type UserTypeFilterOperatorInput struct {
+	In []string `json:"in"`
+}
+
+type UserStateFilterOperatorInput struct {
+	In []string `json:"in"`
+}
+
+// NewUserFilterInput returns nil when there are no filters, the email domain is matched as an email suffix.
+func NewUserFilterInput(input *model.UsersFilter) *UserFilterInput {
+	if input == nil || *input == (model.UsersFilter{}) {
+		return nil
+	}
+
+	filter := &UserFilterInput{}
+
+	if input.FirstName != nil {
+		filter.FirstName = &StringFilterOperationInput{Eq: *input.FirstName}
+	}
+
+	if input.LastName != nil {
+		filter.LastName = &StringFilterOperationInput{Eq: *input.LastName}
+	}
+
+	if input.EmailRegexp != nil || input.EmailDomain != nil {
+		filter.Email = &StringFilterOperationInput{}
+
+		if input.EmailRegexp != nil {
+			filter.Email.Regexp = *input.EmailRegexp
+		}
+
+		if input.EmailDomain != nil {
+			filter.Email.EndsWith = "@" + *input.EmailDomain
+		}
+	}
+
+	if input.Role != nil {
+		filter.Role = &UserRoleFilterOperatorInput{In: []string{*input.Role}}
+	}
+
+	if input.Type != nil {
+		filter.Type = &UserTypeFilterOperatorInput{In: []string{*input.Type}}
+	}
+
+	if input.State != nil {
+		filter.State = &UserStateFilterOperatorInput{In: []string{*input.State}}
+	}
+
+	return filter
+}
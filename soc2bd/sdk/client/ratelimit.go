@@ -0,0 +1,212 @@
+package client
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	headerRetryAfter          = "Retry-After"
+	headerRateLimitReset      = "RateLimit-Reset"
+	headerXRateLimitReset     = "X-RateLimit-Reset"
+	headerRateLimitRemaining  = "RateLimit-Remaining"
+	headerXRateLimitRemaining = "X-RateLimit-Remaining"
+
+	// reset headers with bigger values are unix timestamps, not delays in seconds.
+	maxResetDelaySeconds = 365 * 24 * 60 * 60
+)
+
+// rateLimiter is a token bucket limiting the rate of requests sent to the API.
+// On top of the bucket it can be paused till the moment the API asked to back off to,
+// so concurrent requests don't hammer the API while it throttles.
+type rateLimiter struct {
+	mutex    sync.Mutex
+	rate     float64
+	burst    float64
+	tokens   float64
+	last     time.Time
+	blockTil time.Time
+	now      func() time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &rateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// wait blocks till a request is allowed or the context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	for {
+		delay := l.reserve()
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return ctx.Err() //nolint:wrapcheck
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token if available, otherwise returns the time to wait for the next one.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+
+	if now.Before(l.blockTil) {
+		return l.blockTil.Sub(now)
+	}
+
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// pause blocks all requests for the given duration.
+func (l *rateLimiter) pause(duration time.Duration) {
+	if l == nil || duration <= 0 {
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if until := l.now().Add(duration); until.After(l.blockTil) {
+		l.blockTil = until
+	}
+}
+
+func isThrottled(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusTooManyRequests
+}
+
+// parseRetryAfter returns the delay the API asked to wait before the next request,
+// taken from Retry-After or rate limit reset headers.
+func parseRetryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	if value := header.Get(headerRetryAfter); value != "" {
+		if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+			return secondsToDuration(seconds), true
+		}
+
+		if date, err := http.ParseTime(value); err == nil {
+			return nonNegative(date.Sub(now)), true
+		}
+	}
+
+	if !rateLimitExhausted(header) {
+		return 0, false
+	}
+
+	for _, key := range []string{headerRateLimitReset, headerXRateLimitReset} {
+		value, err := strconv.ParseFloat(header.Get(key), 64)
+		if err != nil {
+			continue
+		}
+
+		if value > maxResetDelaySeconds {
+			return nonNegative(time.Unix(int64(value), 0).Sub(now)), true
+		}
+
+		return secondsToDuration(value), true
+	}
+
+	return 0, false
+}
+
+// rateLimitExhausted reports whether rate limit reset headers are applicable:
+// they are used when no remaining requests are left or the remaining count is not sent.
+func rateLimitExhausted(header http.Header) bool {
+	for _, key := range []string{headerRateLimitRemaining, headerXRateLimitRemaining} {
+		if value := header.Get(key); value != "" {
+			return value == "0"
+		}
+	}
+
+	return true
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return nonNegative(time.Duration(seconds * float64(time.Second)))
+}
+
+func nonNegative(duration time.Duration) time.Duration {
+	if duration < 0 {
+		return 0
+	}
+
+	return duration
+}
+
+// newBackoff honors the delay requested by the API on throttled responses, up to the retry wait max,
+// and falls back to the exponential backoff otherwise.
+func newBackoff(limiter *rateLimiter) retryablehttp.Backoff {
+	return func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+		if resp == nil || !isThrottled(resp) && resp.StatusCode != http.StatusServiceUnavailable {
+			return retryablehttp.DefaultBackoff(min, max, attemptNum, resp)
+		}
+
+		delay, ok := parseRetryAfter(resp.Header, time.Now())
+		if !ok {
+			delay = retryablehttp.DefaultBackoff(min, max, attemptNum, resp)
+		}
+
+		// a bogus header must not stall every request for hours
+		if delay > max {
+			delay = max
+		}
+
+		if isThrottled(resp) {
+			if resp.Request != nil {
+				tflog.SubsystemWarn(resp.Request.Context(), LogSubsystem, "Soc2bd API is throttling requests", map[string]interface{}{
+					"delay":       delay.String(),
+					LogFieldRetry: attemptNum + 1,
+				})
+			}
+
+			limiter.pause(delay)
+		}
+
+		return delay
+	}
+}
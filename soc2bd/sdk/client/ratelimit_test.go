@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		header   http.Header
+		expected time.Duration
+		ok       bool
+	}{
+		{header: http.Header{}, ok: false},
+		{header: http.Header{"Retry-After": {"3"}}, expected: 3 * time.Second, ok: true},
+		{header: http.Header{"Retry-After": {"0.5"}}, expected: 500 * time.Millisecond, ok: true},
+		{header: http.Header{"Retry-After": {now.Add(time.Minute).Format(http.TimeFormat)}}, expected: time.Minute, ok: true},
+		{header: http.Header{"Retry-After": {now.Add(-time.Minute).Format(http.TimeFormat)}}, expected: 0, ok: true},
+		{header: http.Header{"X-Ratelimit-Reset": {"7"}}, expected: 7 * time.Second, ok: true},
+		{header: http.Header{"Ratelimit-Remaining": {"0"}, "Ratelimit-Reset": {"2"}}, expected: 2 * time.Second, ok: true},
+		{header: http.Header{"X-Ratelimit-Remaining": {"10"}, "X-Ratelimit-Reset": {"2"}}, ok: false},
+		{header: http.Header{"X-Ratelimit-Reset": {strconv.FormatInt(now.Add(30*time.Second).Unix(), 10)}}, expected: 30 * time.Second, ok: true},
+		{header: http.Header{"Retry-After": {"invalid"}}, ok: false},
+	}
+
+	for _, c := range cases {
+		actual, ok := parseRetryAfter(c.header, now)
+
+		assert.Equal(t, c.ok, ok, c.header)
+		assert.Equal(t, c.expected, actual, c.header)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	limiter := newRateLimiter(2, 2)
+	limiter.now = func() time.Time { return now }
+
+	assert.Zero(t, limiter.reserve())
+	assert.Zero(t, limiter.reserve())
+	assert.Equal(t, 500*time.Millisecond, limiter.reserve())
+
+	now = now.Add(500 * time.Millisecond)
+	assert.Zero(t, limiter.reserve())
+
+	limiter.pause(3 * time.Second)
+	assert.Equal(t, 3*time.Second, limiter.reserve())
+
+	assert.Nil(t, newRateLimiter(0, 10))
+	assert.NoError(t, newRateLimiter(0, 10).wait(context.Background()))
+}
+
+func TestBackoffCapsRetryAfter(t *testing.T) {
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	limiter := newRateLimiter(1, 1)
+	limiter.now = func() time.Time { return now }
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"3600"}}}
+
+	assert.Equal(t, 30*time.Second, newBackoff(limiter)(time.Second, 30*time.Second, 0, resp))
+	assert.Equal(t, 30*time.Second, limiter.reserve())
+
+	resp.Header.Set("Retry-After", "3")
+	assert.Equal(t, 3*time.Second, newBackoff(nil)(time.Second, 30*time.Second, 0, resp))
+}
+
+func TestRateLimiterWaitCancelled(t *testing.T) {
+	limiter := newRateLimiter(0.001, 1)
+	assert.NoError(t, limiter.wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, limiter.wait(ctx), context.DeadlineExceeded)
+}
+
+func newThrottlingServer(throttledResponses int32, body string) (*httptest.Server, *int32) {
+	var calls int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= throttledResponses {
+			writer.Header().Set(headerRetryAfter, "0")
+			writer.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+
+		_, _ = writer.Write([]byte(body))
+	}))
+
+	return srv, &calls
+}
+
+func TestClientRetriesThrottledRequests(t *testing.T) {
+	srv, calls := newThrottlingServer(2, `{"data": {"user": {"id": "id", "email": "user@email"}}}`)
+	defer srv.Close()
+
+	client := NewClient(WithURL(srv.URL), WithAPIToken("token"), WithHTTPMaxRetry(3))
+
+	user, err := client.ReadUser(context.Background(), "id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "user@email", user.Email)
+	assert.EqualValues(t, 3, atomic.LoadInt32(calls))
+}
+
+func TestClientThrottledError(t *testing.T) {
+	srv, _ := newThrottlingServer(10, `{}`)
+	defer srv.Close()
+
+	client := NewClient(WithURL(srv.URL), WithAPIToken("token"), WithHTTPMaxRetry(1))
+
+	_, err := client.ReadUser(context.Background(), "id")
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, ErrorKindThrottled, apiErr.Kind())
+	assert.Equal(t, ErrorKindThrottled, ErrorKindOf(err))
+	assert.ErrorIs(t, err, ErrTooManyRequests)
+	assert.ErrorContains(t, err, "request throttled by Soc2bd API")
+
+	_, err = client.post(context.Background(), "/hello", "hello", nil)
+	assert.ErrorIs(t, err, ErrTooManyRequests)
+}
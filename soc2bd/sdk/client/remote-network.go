@@ -2,9 +2,10 @@ package client
 
 import (
 	"context"
+	"fmt"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client/query"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client/query"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 )
 
 type RemoteNetworkLocation string
@@ -39,7 +40,7 @@ func (client *Client) ReadRemoteNetworks(ctx context.Context) ([]*model.RemoteNe
 	)
 
 	response := query.ReadRemoteNetworks{}
-	if err := client.query(ctx, &response, variables, opr.withCustomName("readRemoteNetworks"), attr{id: "All"}); err != nil {
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readRemoteNetworks"), attr{id: idAll}); err != nil {
 		return nil, err
 	}
 
@@ -132,3 +133,45 @@ func (client *Client) DeleteRemoteNetwork(ctx context.Context, remoteNetworkID s
 
 	return client.mutate(ctx, &response, newVars(gqlID(remoteNetworkID)), opr, attr{id: remoteNetworkID})
 }
+
+// ReadRemoteNetworkConnectors reads the connectors of the remote network with their health.
+func (client *Client) ReadRemoteNetworkConnectors(ctx context.Context, remoteNetworkID string) ([]*model.Connector, error) {
+	opr := resourceRemoteNetwork.read()
+
+	if remoteNetworkID == "" {
+		return nil, opr.apiError(ErrGraphqlNetworkIDIsEmpty)
+	}
+
+	variables := newVars(
+		gqlID(remoteNetworkID),
+		cursor(query.CursorConnectors),
+		pageLimit(client.pageLimit),
+	)
+
+	response := query.ReadRemoteNetworkConnectors{}
+	if err := client.query(ctx, &response, variables,
+		opr.withCustomName("readRemoteNetworkConnectors"), attr{id: remoteNetworkID}); err != nil {
+		return nil, err
+	}
+
+	if err := response.RemoteNetwork.Connectors.FetchPages(ctx, client.readRemoteNetworkConnectorsAfter, variables); err != nil {
+		return nil, err //nolint
+	}
+
+	return response.ToModel(), nil
+}
+
+func (client *Client) readRemoteNetworkConnectorsAfter(ctx context.Context, variables map[string]interface{}, cursor string) (*query.PaginatedResource[*query.ConnectorEdge], error) {
+	opr := resourceRemoteNetwork.read()
+
+	variables[query.CursorConnectors] = cursor
+	remoteNetworkID := fmt.Sprintf("%v", variables["id"])
+
+	response := query.ReadRemoteNetworkConnectors{}
+	if err := client.query(ctx, &response, variables,
+		opr.withCustomName("readRemoteNetworkConnectors"), attr{id: remoteNetworkID}); err != nil {
+		return nil, err
+	}
+
+	return &response.RemoteNetwork.Connectors.PaginatedResource, nil
+}
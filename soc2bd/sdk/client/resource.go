@@ -4,9 +4,9 @@ import (
 	"context"
 	"errors"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client/query"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/utils"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client/query"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 	"github.com/hasura/go-graphql-client"
 )
 
@@ -168,7 +168,7 @@ func (client *Client) ReadResources(ctx context.Context) ([]*model.Resource, err
 	)
 
 	response := query.ReadResources{}
-	if err := client.query(ctx, &response, variables, opr.withCustomName("readResources"), attr{id: "All"}); err != nil && !errors.Is(err, ErrGraphqlResultIsEmpty) {
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readResources"), attr{id: idAll}); err != nil && !errors.Is(err, ErrGraphqlResultIsEmpty) {
 		return nil, err
 	}
 
@@ -273,7 +273,7 @@ func (client *Client) ReadResourcesByName(ctx context.Context, name string) ([]*
 	)
 
 	response := query.ReadResourcesByName{}
-	if err := client.query(ctx, &response, variables, opr, attr{id: "All"}); err != nil {
+	if err := client.query(ctx, &response, variables, opr, attr{id: idAll}); err != nil {
 		return nil, err
 	}
 
@@ -290,13 +290,85 @@ func (client *Client) readResourcesByNameAfter(ctx context.Context, variables ma
 	variables[query.CursorResources] = cursor
 
 	response := query.ReadResourcesByName{}
-	if err := client.query(ctx, &response, variables, opr.withCustomName("readResources"), attr{id: "All"}); err != nil {
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readResources"), attr{id: idAll}); err != nil {
 		return nil, err
 	}
 
 	return &response.PaginatedResource, nil
 }
 
+// ReadResourcesByFilter reads the resources matching the filter, the name filters are applied by the API
+// and the others by the client, as the API doesn't support them.
+func (client *Client) ReadResourcesByFilter(ctx context.Context, filter *model.ResourcesFilter) ([]*model.Resource, error) {
+	opr := resourceResource.read()
+
+	variables := newVars(
+		gqlNullable(query.NewResourceFilterInput(filter), "filter"),
+		cursor(query.CursorResources),
+		pageLimit(client.pageLimit),
+	)
+
+	response := query.ReadResourcesByFilter{}
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readResources"), attr{id: idAll}); err != nil {
+		return nil, err
+	}
+
+	if err := response.FetchPages(ctx, client.readResourcesByFilterAfter, variables); err != nil {
+		return nil, err //nolint
+	}
+
+	resources := utils.Filter(response.ToModel(), filter.MatchResource)
+
+	return client.filterResourcesByAccess(ctx, resources, filter)
+}
+
+func (client *Client) readResourcesByFilterAfter(ctx context.Context, variables map[string]interface{}, cursor string) (*query.PaginatedResource[*query.ResourceEdge], error) {
+	opr := resourceResource.read()
+
+	variables[query.CursorResources] = cursor
+
+	response := query.ReadResourcesByFilter{}
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readResources"), attr{id: idAll}); err != nil {
+		return nil, err
+	}
+
+	return &response.PaginatedResource, nil
+}
+
+func (client *Client) filterResourcesByAccess(ctx context.Context, resources []*model.Resource, filter *model.ResourcesFilter) ([]*model.Resource, error) {
+	if filter == nil || len(resources) == 0 {
+		return resources, nil
+	}
+
+	if filter.GroupID != nil {
+		resourceIDs, err := client.ReadGroupResources(ctx, *filter.GroupID)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = filterResourcesByID(resources, resourceIDs)
+	}
+
+	if filter.ServiceAccountID != nil {
+		serviceAccount, err := client.ReadServiceAccount(ctx, *filter.ServiceAccountID)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = filterResourcesByID(resources, serviceAccount.Resources)
+	}
+
+	return resources, nil
+}
+
+func filterResourcesByID(resources []*model.Resource, resourceIDs []string) []*model.Resource {
+	ids := utils.MakeLookupMap(resourceIDs)
+
+	return utils.Filter(resources, func(resource *model.Resource) bool {
+		return ids[resource.ID]
+	})
+}
+
 func (client *Client) DeleteResourceServiceAccounts(ctx context.Context, resourceID string, deleteServiceAccountIDs []string) error {
 	opr := resourceResource.update()
 
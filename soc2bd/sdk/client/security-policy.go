@@ -0,0 +1,244 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client/query"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
+)
+
+const queryReadSecurityPolicies = "readSecurityPolicies"
+
+type DevicePostureInput struct {
+	RequireScreenLock       bool     `json:"requireScreenLock"`
+	RequireDiskEncryption   bool     `json:"requireDiskEncryption"`
+	RequireFirewall         bool     `json:"requireFirewall"`
+	AllowedOperatingSystems []string `json:"allowedOperatingSystems"`
+}
+
+func newDevicePostureInput(posture *model.DevicePosture) *DevicePostureInput {
+	if posture == nil {
+		return nil
+	}
+
+	return &DevicePostureInput{
+		RequireScreenLock:       posture.RequireScreenLock,
+		RequireDiskEncryption:   posture.RequireDiskEncryption,
+		RequireFirewall:         posture.RequireFirewall,
+		AllowedOperatingSystems: nonNilStrings(posture.AllowedOperatingSystems),
+	}
+}
+
+func nonNilStrings(items []string) []string {
+	if items == nil {
+		return []string{}
+	}
+
+	return items
+}
+
+func securityPolicyRulesVars(input *model.SecurityPolicy) []gqlVarOption {
+	return []gqlVarOption{
+		gqlVar(input.Name, "name"),
+		gqlVar(input.RequireMFA, "requireMfa"),
+		gqlVar(nonNilStrings(input.MFAMethods), "mfaMethods"),
+		gqlVar(input.SessionLifetimeHours, "sessionLifetimeHours"),
+		gqlVar(newDevicePostureInput(input.DevicePosture), "devicePosture"),
+	}
+}
+
+func (client *Client) CreateSecurityPolicy(ctx context.Context, input *model.SecurityPolicy) (*model.SecurityPolicy, error) {
+	opr := resourceSecurityPolicy.create()
+
+	if input == nil || input.Name == "" {
+		return nil, opr.apiError(ErrGraphqlNameIsEmpty)
+	}
+
+	variables := newVars(append(securityPolicyRulesVars(input),
+		gqlIDs(input.Groups, "groupIds"),
+		cursor(query.CursorGroups),
+		pageLimit(client.pageLimit),
+	)...)
+
+	response := query.CreateSecurityPolicy{}
+	if err := client.mutate(ctx, &response, variables, opr, attr{name: input.Name}); err != nil {
+		return nil, err
+	}
+
+	policy := response.ToModel()
+	policy.Groups = input.Groups
+
+	return policy, nil
+}
+
+// ReadSecurityPolicyRules reads the security policy with its rules and the groups it's assigned to.
+func (client *Client) ReadSecurityPolicyRules(ctx context.Context, securityPolicyID string) (*model.SecurityPolicy, error) {
+	opr := resourceSecurityPolicy.read()
+
+	if securityPolicyID == "" {
+		return nil, opr.apiError(ErrGraphqlIDIsEmpty)
+	}
+
+	variables := newVars(
+		gqlID(securityPolicyID),
+		cursor(query.CursorGroups),
+		pageLimit(client.pageLimit),
+	)
+
+	response := query.ReadSecurityPolicyRules{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: securityPolicyID}); err != nil {
+		return nil, err
+	}
+
+	if err := response.SecurityPolicy.Groups.FetchPages(ctx, client.readSecurityPolicyGroupsAfter, variables); err != nil {
+		return nil, err //nolint
+	}
+
+	return response.ToModel(), nil
+}
+
+func (client *Client) readSecurityPolicyGroupsAfter(ctx context.Context, variables map[string]interface{}, cursor string) (*query.PaginatedResource[*query.GqlGroupIDEdge], error) {
+	opr := resourceSecurityPolicy.read()
+
+	variables[query.CursorGroups] = cursor
+	securityPolicyID := fmt.Sprintf("%v", variables["id"])
+
+	response := query.ReadSecurityPolicyRules{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: securityPolicyID}); err != nil {
+		return nil, err
+	}
+
+	return &response.SecurityPolicy.Groups.PaginatedResource, nil
+}
+
+// UpdateSecurityPolicy updates the policy rules and assigns it to the input groups, the groups it's already assigned to are kept.
+func (client *Client) UpdateSecurityPolicy(ctx context.Context, input *model.SecurityPolicy) (*model.SecurityPolicy, error) {
+	opr := resourceSecurityPolicy.update()
+
+	if input == nil || input.ID == "" {
+		return nil, opr.apiError(ErrGraphqlIDIsEmpty)
+	}
+
+	if input.Name == "" {
+		return nil, opr.apiError(ErrGraphqlNameIsEmpty)
+	}
+
+	variables := newVars(append(securityPolicyRulesVars(input),
+		gqlID(input.ID),
+		gqlIDs(input.Groups, "addedGroupIds"),
+		cursor(query.CursorGroups),
+		pageLimit(client.pageLimit),
+	)...)
+
+	response := query.UpdateSecurityPolicy{}
+	if err := client.mutate(ctx, &response, variables, opr, attr{id: input.ID}); err != nil {
+		return nil, err
+	}
+
+	if err := response.Entity.Groups.FetchPages(ctx, client.readSecurityPolicyGroupsAfter,
+		newVars(gqlID(input.ID), pageLimit(client.pageLimit))); err != nil {
+		return nil, err //nolint
+	}
+
+	return response.ToModel(), nil
+}
+
+// DeleteSecurityPolicyGroups unassigns the policy from the groups, they fall back to the default policy.
+func (client *Client) DeleteSecurityPolicyGroups(ctx context.Context, securityPolicyID string, groupIDs []string) error {
+	opr := resourceSecurityPolicy.update()
+
+	if len(groupIDs) == 0 {
+		return nil
+	}
+
+	if securityPolicyID == "" {
+		return opr.apiError(ErrGraphqlIDIsEmpty)
+	}
+
+	variables := newVars(
+		gqlID(securityPolicyID),
+		gqlIDs(groupIDs, "removedGroupIds"),
+		cursor(query.CursorGroups),
+		pageLimit(client.pageLimit),
+	)
+
+	response := query.UpdateSecurityPolicyRemoveGroups{}
+
+	return client.mutate(ctx, &response, variables, opr, attr{id: securityPolicyID})
+}
+
+func (client *Client) DeleteSecurityPolicy(ctx context.Context, securityPolicyID string) error {
+	opr := resourceSecurityPolicy.delete()
+
+	if securityPolicyID == "" {
+		return opr.apiError(ErrGraphqlIDIsEmpty)
+	}
+
+	response := query.DeleteSecurityPolicy{}
+
+	return client.mutate(ctx, &response, newVars(gqlID(securityPolicyID)), opr, attr{id: securityPolicyID})
+}
+
+func (client *Client) ReadSecurityPolicy(ctx context.Context, securityPolicyID, securityPolicyName string) (*model.SecurityPolicy, error) {
+	opr := resourceSecurityPolicy.read()
+
+	if securityPolicyID == "" && securityPolicyName == "" {
+		return nil, opr.apiError(ErrGraphqlEmptyBothNameAndID)
+	}
+
+	variables := newVars(
+		gqlID(securityPolicyID),
+		gqlNullable(securityPolicyName, "name"),
+	)
+
+	response := query.ReadSecurityPolicy{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: securityPolicyID}); err != nil {
+		return nil, err
+	}
+
+	return response.ToModel(), nil
+}
+
+func (client *Client) ReadSecurityPolicies(ctx context.Context) ([]*model.SecurityPolicy, error) {
+	opr := resourceSecurityPolicy.read()
+
+	variables := newVars(
+		cursor(query.CursorPolicies),
+		pageLimit(client.pageLimit),
+	)
+
+	response := query.ReadSecurityPolicies{}
+
+	err := client.query(ctx, &response, variables, opr.withCustomName(queryReadSecurityPolicies))
+	if err != nil {
+		if errors.Is(err, ErrGraphqlResultIsEmpty) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	err = response.FetchPages(ctx, client.readSecurityPoliciesAfter, variables)
+	if err != nil {
+		return nil, opr.apiError(err)
+	}
+
+	return response.ToModel(), nil
+}
+
+func (client *Client) readSecurityPoliciesAfter(ctx context.Context, variables map[string]interface{}, cursor string) (*query.PaginatedResource[*query.SecurityPolicyEdge], error) {
+	opr := resourceSecurityPolicy.read()
+
+	variables[query.CursorPolicies] = cursor
+
+	response := query.ReadSecurityPolicies{}
+
+	err := client.query(ctx, &response, variables, opr.withCustomName(queryReadSecurityPolicies))
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.PaginatedResource, nil
+}
@@ -4,9 +4,9 @@ import (
 	"context"
 	"errors"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client/query"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/utils"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client/query"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 )
 
 const (
@@ -92,7 +92,7 @@ func (client *Client) ReadShallowServiceAccounts(ctx context.Context) ([]*model.
 	)
 
 	response := query.ReadShallowServiceAccounts{}
-	if err := client.query(ctx, &response, variables, opr, attr{id: "All"}); err != nil {
+	if err := client.query(ctx, &response, variables, opr, attr{id: idAll}); err != nil {
 		return nil, err
 	}
 
@@ -109,7 +109,7 @@ func (client *Client) readServiceAccountsAfter(ctx context.Context, variables ma
 	variables[query.CursorServices] = cursor
 
 	response := query.ReadShallowServiceAccounts{}
-	if err := client.query(ctx, &response, variables, opr.withCustomName(queryReadServiceAccounts), attr{id: "All"}); err != nil {
+	if err := client.query(ctx, &response, variables, opr.withCustomName(queryReadServiceAccounts), attr{id: idAll}); err != nil {
 		return nil, err
 	}
 
@@ -133,7 +133,7 @@ func (client *Client) ReadServiceAccounts(ctx context.Context, input ...string)
 	)
 
 	response := query.ReadServiceAccounts{}
-	if err := client.query(ctx, &response, variables, opr.withCustomName(queryReadServiceAccounts), attr{id: "All"}); err != nil {
+	if err := client.query(ctx, &response, variables, opr.withCustomName(queryReadServiceAccounts), attr{id: idAll}); err != nil {
 		if errors.Is(err, ErrGraphqlResultIsEmpty) {
 			return nil, nil
 		}
@@ -161,7 +161,7 @@ func (client *Client) readServicesAfter(ctx context.Context, variables map[strin
 	variables[query.CursorServices] = cursor
 
 	response := query.ReadServiceAccounts{}
-	if err := client.query(ctx, &response, variables, opr.withCustomName(queryReadServices), attr{id: "All"}); err != nil {
+	if err := client.query(ctx, &response, variables, opr.withCustomName(queryReadServices), attr{id: idAll}); err != nil {
 		return nil, err
 	}
 
@@ -175,7 +175,7 @@ func (client *Client) readServiceResourcesAfter(ctx context.Context, variables m
 	variables[query.CursorResources] = cursor
 
 	response := query.ReadServiceAccount{}
-	if err := client.query(ctx, &response, variables, opr.withCustomName(queryReadServices), attr{id: "All"}); err != nil {
+	if err := client.query(ctx, &response, variables, opr.withCustomName(queryReadServices), attr{id: idAll}); err != nil {
 		return nil, err
 	}
 
@@ -189,7 +189,7 @@ func (client *Client) readServiceKeysAfter(ctx context.Context, variables map[st
 	variables[query.CursorServiceKeys] = cursor
 
 	response := query.ReadServiceAccount{}
-	if err := client.query(ctx, &response, variables, opr.withCustomName(queryReadServices), attr{id: "All"}); err != nil {
+	if err := client.query(ctx, &response, variables, opr.withCustomName(queryReadServices), attr{id: idAll}); err != nil {
 		return nil, err
 	}
 
@@ -254,7 +254,7 @@ func (client *Client) UpdateServiceAccountRemoveResources(ctx context.Context, s
 	}
 
 	_, err := client.ReadShallowServiceAccount(ctx, serviceAccountID)
-	if errors.Is(err, ErrGraphqlResultIsEmpty) {
+	if IsNotFound(err) {
 		// no-op - service does not exist
 		return nil
 	}
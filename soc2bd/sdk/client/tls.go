@@ -0,0 +1,229 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+var (
+	ErrInvalidTLSConfig   = errors.New("invalid TLS configuration")
+	ErrInvalidProxyConfig = errors.New("invalid proxy configuration")
+)
+
+// configureHTTPTransport applies the TLS and proxy options to the transport the client's transport wraps.
+func configureHTTPTransport(httpTransport *http.Transport, cfg *config) error {
+	tlsConfig, err := newTLSConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	if tlsConfig != nil {
+		httpTransport.TLSClientConfig = tlsConfig
+	}
+
+	proxy, err := newProxyFunc(cfg.proxyURL, cfg.noProxy)
+	if err != nil {
+		return err
+	}
+
+	httpTransport.Proxy = proxy
+
+	return nil
+}
+
+// newTLSConfig returns nil when no TLS option is set, so the transport defaults are kept.
+func newTLSConfig(cfg *config) (*tls.Config, error) {
+	if cfg.caCertPEM == "" && cfg.caCertFile == "" && cfg.clientCertPEM == "" && cfg.clientKeyPEM == "" && !cfg.insecureSkipVerify {
+		return nil, nil //nolint:nilnil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.insecureSkipVerify, //nolint:gosec
+	}
+
+	rootCAs, err := newCertPool(cfg.caCertPEM, cfg.caCertFile)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig.RootCAs = rootCAs
+
+	if cfg.clientCertPEM != "" || cfg.clientKeyPEM != "" {
+		if cfg.clientCertPEM == "" || cfg.clientKeyPEM == "" {
+			return nil, fmt.Errorf("%w: both the client certificate and key have to be set", ErrInvalidTLSConfig)
+		}
+
+		certificate, err := tls.X509KeyPair([]byte(cfg.clientCertPEM), []byte(cfg.clientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("%w: can't load the client certificate: %s", ErrInvalidTLSConfig, err.Error())
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+// newCertPool adds the CA certificates to the system ones, it returns nil to use the system ones when none is set.
+func newCertPool(caCertPEM, caCertFile string) (*x509.CertPool, error) {
+	if caCertPEM == "" && caCertFile == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	if caCertPEM != "" && !pool.AppendCertsFromPEM([]byte(caCertPEM)) {
+		return nil, fmt.Errorf("%w: no certificate found in the CA certificate PEM", ErrInvalidTLSConfig)
+	}
+
+	if caCertFile == "" {
+		return pool, nil
+	}
+
+	content, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w: can't read the CA certificate file: %s", ErrInvalidTLSConfig, err.Error())
+	}
+
+	if !pool.AppendCertsFromPEM(content) {
+		return nil, fmt.Errorf("%w: no certificate found in the CA certificate file %s", ErrInvalidTLSConfig, caCertFile)
+	}
+
+	return pool, nil
+}
+
+// isCertificateError reports whether the server certificate can't be verified, retrying won't help.
+func isCertificateError(err error) bool {
+	var (
+		hostnameErr         x509.HostnameError
+		unknownAuthorityErr x509.UnknownAuthorityError
+		invalidErr          x509.CertificateInvalidError
+	)
+
+	return errors.As(err, &hostnameErr) || errors.As(err, &unknownAuthorityErr) || errors.As(err, &invalidErr)
+}
+
+// newProxyFunc uses the proxy URL when set and the HTTPS_PROXY environment variable otherwise,
+// the hosts matching no proxy are called directly.
+func newProxyFunc(proxyURL, noProxy string) (func(*http.Request) (*url.URL, error), error) {
+	proxy := http.ProxyFromEnvironment
+
+	if proxyURL != "" {
+		parsed, err := url.Parse(proxyURL)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return nil, fmt.Errorf("%w: proxy URL %q must be an absolute URL, e.g. http://proxy.corp:3128", ErrInvalidProxyConfig, proxyURL)
+		}
+
+		proxy = http.ProxyURL(parsed)
+	}
+
+	if strings.TrimSpace(noProxy) == "" {
+		return proxy, nil
+	}
+
+	bypass := parseNoProxy(noProxy)
+
+	return func(req *http.Request) (*url.URL, error) {
+		if bypass.matches(req.URL) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return proxy(req)
+	}, nil
+}
+
+// noProxy is a comma separated list of hosts, domains, IPs or CIDRs, optionally with a port, or `*` for every host.
+// A domain matches its subdomains too, with or without a leading dot.
+type noProxy struct {
+	all      bool
+	networks []*net.IPNet
+	hosts    []noProxyHost
+}
+
+type noProxyHost struct {
+	host string
+	port string
+}
+
+func parseNoProxy(value string) noProxy {
+	var bypass noProxy
+
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+
+		switch {
+		case entry == "":
+			continue
+		case entry == "*":
+			bypass.all = true
+
+			continue
+		}
+
+		if _, network, err := net.ParseCIDR(entry); err == nil {
+			bypass.networks = append(bypass.networks, network)
+
+			continue
+		}
+
+		host, port, err := net.SplitHostPort(entry)
+		if err != nil {
+			host, port = entry, ""
+		}
+
+		bypass.hosts = append(bypass.hosts, noProxyHost{host: strings.Trim(strings.TrimPrefix(host, "."), "[]"), port: port})
+	}
+
+	return bypass
+}
+
+func (p noProxy) matches(target *url.URL) bool {
+	if p.all {
+		return true
+	}
+
+	host := strings.ToLower(target.Hostname())
+
+	if ip := net.ParseIP(host); ip != nil {
+		for _, network := range p.networks {
+			if network.Contains(ip) {
+				return true
+			}
+		}
+	}
+
+	for _, entry := range p.hosts {
+		if entry.port != "" && entry.port != targetPort(target) {
+			continue
+		}
+
+		if host == entry.host || strings.HasSuffix(host, "."+entry.host) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func targetPort(target *url.URL) string {
+	if port := target.Port(); port != "" {
+		return port
+	}
+
+	if target.Scheme == "http" {
+		return "80"
+	}
+
+	return "443"
+}
@@ -0,0 +1,219 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const userResponse = `{"data": {"user": {"id": "id", "email": "user@email"}}}`
+
+func certificatePEM(cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
+
+// newClientCertificate returns a self signed client certificate and its private key, PEM encoded.
+func newClientCertificate(t *testing.T) (*x509.Certificate, string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return cert, certificatePEM(cert), string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+func newUserHandler(calls *int32) http.HandlerFunc {
+	return func(writer http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(calls, 1)
+		_, _ = writer.Write([]byte(userResponse))
+	}
+}
+
+func TestClientCACert(t *testing.T) {
+	var calls int32
+
+	srv := httptest.NewTLSServer(newUserHandler(&calls))
+	defer srv.Close()
+
+	client := NewClient(WithURL(srv.URL), WithAPIToken("token"), WithHTTPMaxRetry(3))
+	_, err := client.ReadUser(context.Background(), "id")
+
+	assert.ErrorContains(t, err, "certificate")
+	assert.EqualValues(t, 0, atomic.LoadInt32(&calls))
+
+	client = NewClient(WithURL(srv.URL), WithAPIToken("token"), WithCACert(certificatePEM(srv.Certificate())))
+	require.NoError(t, client.ConfigError())
+
+	user, err := client.ReadUser(context.Background(), "id")
+	require.NoError(t, err)
+	assert.Equal(t, "user@email", user.Email)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte(certificatePEM(srv.Certificate())), 0o600))
+
+	client = NewClient(WithURL(srv.URL), WithAPIToken("token"), WithCACertFile(caFile))
+	_, err = client.ReadUser(context.Background(), "id")
+	assert.NoError(t, err)
+
+	client = NewClient(WithURL(srv.URL), WithAPIToken("token"), WithInsecureSkipVerify(true))
+	_, err = client.ReadUser(context.Background(), "id")
+	assert.NoError(t, err)
+}
+
+func TestClientCertificate(t *testing.T) {
+	cert, certPEM, keyPEM := newClientCertificate(t)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(cert)
+
+	var calls int32
+
+	srv := httptest.NewUnstartedServer(newUserHandler(&calls))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs, MinVersion: tls.VersionTLS12}
+	srv.StartTLS()
+	defer srv.Close()
+
+	caCert := WithCACert(certificatePEM(srv.Certificate()))
+
+	client := NewClient(WithURL(srv.URL), WithAPIToken("token"), WithHTTPMaxRetry(0), caCert)
+	_, err := client.ReadUser(context.Background(), "id")
+	assert.Error(t, err)
+
+	client = NewClient(WithURL(srv.URL), WithAPIToken("token"), caCert, WithClientCertificate(certPEM, keyPEM))
+	require.NoError(t, client.ConfigError())
+
+	user, err := client.ReadUser(context.Background(), "id")
+	require.NoError(t, err)
+	assert.Equal(t, "user@email", user.Email)
+}
+
+func TestClientProxy(t *testing.T) {
+	var (
+		calls   int32
+		proxied string
+	)
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		proxied = req.URL.String()
+		newUserHandler(&calls)(writer, req)
+	}))
+	defer proxy.Close()
+
+	client := NewClient(WithURL("http://api.soc2bd.test"), WithAPIToken("token"), WithProxy(proxy.URL, "localhost, .corp.test"))
+	require.NoError(t, client.ConfigError())
+
+	user, err := client.ReadUser(context.Background(), "id")
+	require.NoError(t, err)
+	assert.Equal(t, "user@email", user.Email)
+	assert.Equal(t, "http://api.soc2bd.test/api/graphql/", proxied)
+
+	client = NewClient(WithURL("http://api.soc2bd.test"), WithAPIToken("token"), WithHTTPMaxRetry(0), WithProxy(proxy.URL, "soc2bd.test"))
+	_, err = client.ReadUser(context.Background(), "id")
+	assert.Error(t, err)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls))
+}
+
+func TestClientInvalidTransportConfig(t *testing.T) {
+	_, certPEM, keyPEM := newClientCertificate(t)
+
+	cases := []struct {
+		opts     []Option
+		expected error
+	}{
+		{opts: []Option{WithCACert("not a certificate")}, expected: ErrInvalidTLSConfig},
+		{opts: []Option{WithCACertFile(filepath.Join(t.TempDir(), "missing.pem"))}, expected: ErrInvalidTLSConfig},
+		{opts: []Option{WithClientCertificate(certPEM, "")}, expected: ErrInvalidTLSConfig},
+		{opts: []Option{WithClientCertificate(certPEM, certPEM)}, expected: ErrInvalidTLSConfig},
+		{opts: []Option{WithProxy("proxy.corp:3128", "")}, expected: ErrInvalidProxyConfig},
+		{opts: []Option{WithClientCertificate(certPEM, keyPEM), WithProxy("http://proxy.corp:3128", "*")}, expected: nil},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			client := NewClient(append(c.opts, WithURL("http://127.0.0.1:1"), WithAPIToken("token"), WithHTTPMaxRetry(3))...)
+
+			if c.expected == nil {
+				assert.NoError(t, client.ConfigError())
+
+				return
+			}
+
+			assert.ErrorIs(t, client.ConfigError(), c.expected)
+
+			start := time.Now()
+			_, err := client.post(context.Background(), "/hello", "hello", nil)
+
+			assert.ErrorIs(t, err, c.expected)
+			assert.Less(t, time.Since(start), time.Second, "invalid options must not be retried")
+		})
+	}
+}
+
+func TestNoProxyMatches(t *testing.T) {
+	cases := []struct {
+		noProxy  string
+		target   string
+		expected bool
+	}{
+		{noProxy: "*", target: "https://autoco.soc2bd.com", expected: true},
+		{noProxy: "soc2bd.com", target: "https://autoco.soc2bd.com", expected: true},
+		{noProxy: ".soc2bd.com", target: "https://autoco.soc2bd.com", expected: true},
+		{noProxy: "soc2bd.com", target: "https://soc2bd.com", expected: true},
+		{noProxy: "soc2bd.com", target: "https://notsoc2bd.com", expected: false},
+		{noProxy: "AUTOCO.soc2bd.com:443", target: "https://autoco.soc2bd.com", expected: true},
+		{noProxy: "autoco.soc2bd.com:8443", target: "https://autoco.soc2bd.com", expected: false},
+		{noProxy: "autoco.soc2bd.com:8443", target: "https://autoco.soc2bd.com:8443", expected: true},
+		{noProxy: "10.0.0.0/8", target: "http://10.1.2.3:8080", expected: true},
+		{noProxy: "10.0.0.0/8", target: "http://192.168.0.1", expected: false},
+		{noProxy: "[::1]", target: "http://[::1]:8080", expected: true},
+		{noProxy: "localhost, 127.0.0.1", target: "http://127.0.0.1:8080", expected: true},
+		{noProxy: " , ", target: "https://autoco.soc2bd.com", expected: false},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			target, err := url.Parse(c.target)
+			require.NoError(t, err)
+
+			assert.Equal(t, c.expected, parseNoProxy(c.noProxy).matches(target))
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultTokenCommandTTL = 5 * time.Minute
+
+	agentTokenURL     = "http://agent/token"
+	agentTokenTimeout = 10 * time.Second
+)
+
+var ErrTokenSource = errors.New("failed to get the API token")
+
+// TokenSource provides the API token sent with every request.
+type TokenSource interface {
+	// Token returns the current API token.
+	Token(ctx context.Context) (string, error)
+	// Invalidate is called when the API rejected the token, the next Token call must not return it from a cache.
+	Invalidate(token string)
+}
+
+func tokenSourceError(source string, err error) error {
+	return fmt.Errorf("%w from %s: %s", ErrTokenSource, source, err.Error())
+}
+
+func nonEmptyToken(token string) (string, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", ErrAPITokenNoSet
+	}
+
+	return token, nil
+}
+
+// staticTokenSource returns the API token set with WithAPIToken, or read from the SOC2BD_API_TOKEN environment variable.
+type staticTokenSource struct {
+	apiToken string
+}
+
+func (s staticTokenSource) Token(_ context.Context) (string, error) {
+	if s.apiToken != "" {
+		return s.apiToken, nil
+	}
+
+	return nonEmptyToken(os.Getenv(EnvAPIToken))
+}
+
+func (s staticTokenSource) Invalidate(string) {}
+
+// fileTokenSource re-reads the token file when it changes, e.g. when it's rotated by another process.
+type fileTokenSource struct {
+	path    string
+	mutex   sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+// NewFileTokenSource returns a TokenSource reading the API token from the file, it's read again whenever the file changes.
+func NewFileTokenSource(path string) TokenSource {
+	return &fileTokenSource{path: path}
+}
+
+func (s *fileTokenSource) Token(_ context.Context) (string, error) {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return "", tokenSourceError("file", err)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.token != "" && info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return s.token, nil
+	}
+
+	content, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", tokenSourceError("file", err)
+	}
+
+	token, err := nonEmptyToken(string(content))
+	if err != nil {
+		return "", err
+	}
+
+	s.token, s.modTime, s.size = token, info.ModTime(), info.Size()
+
+	return s.token, nil
+}
+
+func (s *fileTokenSource) Invalidate(token string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.token == token {
+		s.token = ""
+	}
+}
+
+// commandTokenSource caches the output of a credential helper command for the TTL.
+type commandTokenSource struct {
+	command   []string
+	ttl       time.Duration
+	now       func() time.Time
+	mutex     sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// NewCommandTokenSource returns a TokenSource running the credential helper command, whose output is the API token.
+// The command arguments are separated by spaces, it's run without a shell. The token is cached for the TTL.
+func NewCommandTokenSource(command string, ttl time.Duration) TokenSource {
+	if ttl <= 0 {
+		ttl = DefaultTokenCommandTTL
+	}
+
+	return &commandTokenSource{
+		command: strings.Fields(command),
+		ttl:     ttl,
+		now:     time.Now,
+	}
+}
+
+func (s *commandTokenSource) Token(ctx context.Context) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.token != "" && s.now().Before(s.expiresAt) {
+		return s.token, nil
+	}
+
+	if len(s.command) == 0 {
+		return "", tokenSourceError("command", errors.New("the command is empty")) //nolint:goerr113
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, s.command[0], s.command[1:]...) //#nosec G204 -- the command is set by the provider configuration
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", tokenSourceError(fmt.Sprintf("command %s", s.command[0]), fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String())))
+	}
+
+	token, err := nonEmptyToken(stdout.String())
+	if err != nil {
+		return "", err
+	}
+
+	s.token, s.expiresAt = token, s.now().Add(s.ttl)
+
+	return s.token, nil
+}
+
+func (s *commandTokenSource) Invalidate(token string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.token == token {
+		s.token = ""
+	}
+}
+
+// agentTokenSource asks a local agent for the token on every request, the agent handles its own caching.
+// The agent serves `GET /token` over HTTP on the Unix socket, with `?refresh=true` after the API rejected the token.
+type agentTokenSource struct {
+	httpClient *http.Client
+	mutex      sync.Mutex
+	rejected   string
+}
+
+// NewAgentTokenSource returns a TokenSource asking the agent listening on the Unix socket for the API token.
+func NewAgentTokenSource(socketPath string) TokenSource {
+	dialer := &net.Dialer{}
+
+	return &agentTokenSource{
+		httpClient: &http.Client{
+			Timeout: agentTokenTimeout,
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return dialer.DialContext(ctx, "unix", socketPath)
+				},
+			},
+		},
+	}
+}
+
+func (s *agentTokenSource) Token(ctx context.Context) (string, error) {
+	s.mutex.Lock()
+	refresh := s.rejected != ""
+	s.mutex.Unlock()
+
+	url := agentTokenURL
+	if refresh {
+		url += "?refresh=true"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", tokenSourceError("agent", err)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", tokenSourceError("agent", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", tokenSourceError("agent", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", tokenSourceError("agent", fmt.Errorf("status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))) //nolint:goerr113
+	}
+
+	token, err := nonEmptyToken(string(body))
+	if err != nil {
+		return "", err
+	}
+
+	if refresh {
+		s.mutex.Lock()
+		s.rejected = ""
+		s.mutex.Unlock()
+	}
+
+	return token, nil
+}
+
+func (s *agentTokenSource) Invalidate(token string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.rejected = token
+}
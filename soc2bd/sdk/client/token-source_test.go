@@ -0,0 +1,230 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeToken(t *testing.T, path, token string) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(path, []byte(token+"\n"), 0o600))
+}
+
+func TestFileTokenSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	tokens := NewFileTokenSource(path)
+
+	_, err := tokens.Token(context.Background())
+	assert.ErrorIs(t, err, ErrTokenSource)
+
+	writeToken(t, path, "token-1")
+
+	token, err := tokens.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	writeToken(t, path, "rotated-token-2")
+
+	token, err = tokens.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "rotated-token-2", token)
+
+	writeToken(t, path, "")
+
+	_, err = tokens.Token(context.Background())
+	assert.ErrorIs(t, err, ErrAPITokenNoSet)
+}
+
+func TestCommandTokenSource(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test command is not available on windows")
+	}
+
+	path := filepath.Join(t.TempDir(), "token")
+	writeToken(t, path, "token-1")
+
+	now := time.Now()
+	tokens := NewCommandTokenSource("cat "+path, time.Minute).(*commandTokenSource)
+	tokens.now = func() time.Time { return now }
+
+	token, err := tokens.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	writeToken(t, path, "token-2")
+
+	// the token is cached for the TTL
+	token, err = tokens.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	now = now.Add(time.Minute)
+
+	token, err = tokens.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token)
+
+	writeToken(t, path, "token-3")
+	tokens.Invalidate("another-token")
+
+	token, err = tokens.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token)
+
+	tokens.Invalidate("token-2")
+
+	token, err = tokens.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-3", token)
+
+	_, err = NewCommandTokenSource("cat "+filepath.Join(t.TempDir(), "missing"), 0).Token(context.Background())
+	assert.ErrorIs(t, err, ErrTokenSource)
+	assert.ErrorContains(t, err, "No such file")
+
+	_, err = NewCommandTokenSource(" ", 0).Token(context.Background())
+	assert.ErrorIs(t, err, ErrTokenSource)
+}
+
+// newTokenAgent serves the token agent API on a Unix socket, it returns the socket path and counts the refreshes.
+func newTokenAgent(t *testing.T) (string, *int32) {
+	t.Helper()
+
+	var refreshes int32
+
+	socketPath := filepath.Join(t.TempDir(), "agent.sock")
+
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+
+	srv := &http.Server{ //nolint:gosec
+		Handler: http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != "/token" {
+				writer.WriteHeader(http.StatusNotFound)
+
+				return
+			}
+
+			if req.URL.Query().Get("refresh") == "true" {
+				atomic.AddInt32(&refreshes, 1)
+				_, _ = writer.Write([]byte("token-2"))
+
+				return
+			}
+
+			_, _ = writer.Write([]byte("token-1\n"))
+		}),
+	}
+
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(func() { _ = srv.Close() })
+
+	return socketPath, &refreshes
+}
+
+func TestAgentTokenSource(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("unix sockets are not available on windows")
+	}
+
+	socketPath, refreshes := newTokenAgent(t)
+	tokens := NewAgentTokenSource(socketPath)
+
+	for n, expected := range []string{"token-1", "token-1", "token-2", "token-1"} {
+		if n == 2 {
+			tokens.Invalidate("token-1")
+		}
+
+		token, err := tokens.Token(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, expected, token, fmt.Sprintf("call %d", n))
+	}
+
+	assert.EqualValues(t, 1, atomic.LoadInt32(refreshes))
+
+	_, err := NewAgentTokenSource(filepath.Join(t.TempDir(), "missing.sock")).Token(context.Background())
+	assert.ErrorIs(t, err, ErrTokenSource)
+}
+
+func newTokenCheckingServer(validToken string) (*httptest.Server, *int32) {
+	var calls int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&calls, 1)
+
+		if req.Header.Get(headerAPIKey) != validToken {
+			writer.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		_, _ = writer.Write([]byte(userResponse))
+	}))
+
+	return srv, &calls
+}
+
+func TestClientRefreshesRejectedToken(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test command is not available on windows")
+	}
+
+	srv, calls := newTokenCheckingServer("token-2")
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "token")
+	writeToken(t, path, "token-1")
+
+	client := NewClient(WithURL(srv.URL), WithAPIToken("ignored"), WithTokenSource(NewCommandTokenSource("cat "+path, time.Hour)))
+
+	_, err := client.ReadUser(context.Background(), "id")
+	assert.Error(t, err)
+	assert.EqualValues(t, 1, atomic.LoadInt32(calls), "the token is not retried when the source has no new one")
+
+	// the token is rotated during the run, the cached one is rejected
+	writeToken(t, path, "token-2")
+
+	user, err := client.ReadUser(context.Background(), "id")
+	require.NoError(t, err)
+	assert.Equal(t, "user@email", user.Email)
+	assert.EqualValues(t, 3, atomic.LoadInt32(calls))
+
+	// the refreshed token is used by the next requests
+	_, err = client.post(context.Background(), "/hello", "hello", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, atomic.LoadInt32(calls))
+}
+
+func TestClientKeepsTokenOnRejectedConnectorTokens(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("unix sockets are not available on windows")
+	}
+
+	var calls int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		http.Error(writer, "invalid connector tokens", http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	socketPath, refreshes := newTokenAgent(t)
+	client := NewClient(WithURL(srv.URL), WithTokenSource(NewAgentTokenSource(socketPath)))
+
+	err := client.VerifyConnectorTokens(context.Background(), "refresh-token", "access-token")
+	require.Error(t, err)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls))
+	assert.EqualValues(t, 0, atomic.LoadInt32(refreshes), "the API token is not refreshed when the connector tokens are rejected")
+}
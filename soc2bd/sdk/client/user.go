@@ -3,9 +3,10 @@ package client
 import (
 	"context"
 	"errors"
+	"fmt"
 
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client/query"
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/model"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client/query"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/model"
 )
 
 func (client *Client) ReadUsers(ctx context.Context) ([]*model.User, error) {
@@ -17,7 +18,7 @@ func (client *Client) ReadUsers(ctx context.Context) ([]*model.User, error) {
 	)
 
 	response := query.ReadUsers{}
-	if err := client.query(ctx, &response, variables, opr.withCustomName("readUsers"), attr{id: "All"}); err != nil {
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readUsers"), attr{id: idAll}); err != nil {
 		if errors.Is(err, ErrGraphqlResultIsEmpty) {
 			return nil, nil
 		}
@@ -38,13 +39,70 @@ func (client *Client) readUsersAfter(ctx context.Context, variables map[string]i
 	variables[query.CursorUsers] = cursor
 	response := query.ReadUsers{}
 
-	if err := client.query(ctx, &response, variables, opr.withCustomName("readUsers"), attr{id: "All"}); err != nil {
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readUsers"), attr{id: idAll}); err != nil {
 		return nil, err
 	}
 
 	return &response.PaginatedResource, nil
 }
 
+// ReadUsersByFilter reads the users matching the filter together with the groups they belong to.
+func (client *Client) ReadUsersByFilter(ctx context.Context, filter *model.UsersFilter) ([]*model.User, error) {
+	opr := resourceUser.read()
+
+	variables := newVars(
+		gqlNullable(query.NewUserFilterInput(filter), "filter"),
+		cursor(query.CursorUsers),
+		cursor(query.CursorGroups),
+		pageLimit(client.pageLimit),
+	)
+
+	response := query.ReadUsersByFilter{}
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readUsersByFilter"), attr{id: idAll}); err != nil {
+		return nil, err
+	}
+
+	if err := response.FetchPages(ctx, client.readUsersByFilterAfter, variables); err != nil {
+		return nil, err //nolint
+	}
+
+	for _, edge := range response.Edges {
+		if err := edge.Node.Groups.FetchPages(ctx, client.readUserGroupsAfter,
+			newVars(gqlID(edge.Node.ID), pageLimit(client.pageLimit))); err != nil {
+			return nil, err //nolint
+		}
+	}
+
+	return response.ToModel(), nil
+}
+
+func (client *Client) readUsersByFilterAfter(ctx context.Context, variables map[string]interface{}, cursor string) (*query.PaginatedResource[*query.UserWithGroupsEdge], error) {
+	opr := resourceUser.read()
+
+	variables[query.CursorUsers] = cursor
+	response := query.ReadUsersByFilter{}
+
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readUsersByFilter"), attr{id: idAll}); err != nil {
+		return nil, err
+	}
+
+	return &response.PaginatedResource, nil
+}
+
+func (client *Client) readUserGroupsAfter(ctx context.Context, variables map[string]interface{}, cursor string) (*query.PaginatedResource[*query.GqlGroupIDEdge], error) {
+	opr := resourceUser.read()
+
+	variables[query.CursorGroups] = cursor
+	userID := fmt.Sprintf("%v", variables["id"])
+	response := query.ReadUserGroups{}
+
+	if err := client.query(ctx, &response, variables, opr.withCustomName("readUserGroups"), attr{id: userID}); err != nil {
+		return nil, err
+	}
+
+	return &response.User.Groups.PaginatedResource, nil
+}
+
 func (client *Client) ReadUser(ctx context.Context, userID string) (*model.User, error) {
 	opr := resourceUser.read()
 
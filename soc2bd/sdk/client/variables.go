@@ -1,8 +1,8 @@
 package client
 
 import (
-	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/client/query"
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/utils"
+	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/sdk/client/query"
 	"github.com/hasura/go-graphql-client"
 )
 
@@ -0,0 +1,256 @@
+package model
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	PrincipalTypeUser           = "USER"
+	PrincipalTypeServiceAccount = "SERVICE_ACCOUNT"
+)
+
+//nolint:gochecknoglobals
+var accessReportHeader = []string{
+	"principal_type", "principal_id", "principal_name",
+	"group_id", "group_name", "security_policy_id", "security_policy_name",
+	"resource_id", "resource_name", "resource_address", "tcp", "udp", "allow_icmp",
+}
+
+// AccessInventory holds everything needed to tell who can reach what,
+// the groups must have their users and resources populated.
+type AccessInventory struct {
+	Users            []*User
+	Groups           []*Group
+	Resources        []*Resource
+	ServiceAccounts  []*ServiceAccount
+	SecurityPolicies []*SecurityPolicy
+}
+
+// AccessReportRow is a single principal, group and resource combination,
+// the group is empty for service accounts and the resource is empty when nothing is granted.
+type AccessReportRow struct {
+	PrincipalType      string `json:"principal_type"`
+	PrincipalID        string `json:"principal_id"`
+	PrincipalName      string `json:"principal_name"`
+	GroupID            string `json:"group_id"`
+	GroupName          string `json:"group_name"`
+	SecurityPolicyID   string `json:"security_policy_id"`
+	SecurityPolicyName string `json:"security_policy_name"`
+	ResourceID         string `json:"resource_id"`
+	ResourceName       string `json:"resource_name"`
+	ResourceAddress    string `json:"resource_address"`
+	TCP                string `json:"tcp"`
+	UDP                string `json:"udp"`
+	AllowIcmp          bool   `json:"allow_icmp"`
+}
+
+func (r AccessReportRow) values() []string {
+	return []string{
+		r.PrincipalType, r.PrincipalID, r.PrincipalName,
+		r.GroupID, r.GroupName, r.SecurityPolicyID, r.SecurityPolicyName,
+		r.ResourceID, r.ResourceName, r.ResourceAddress, r.TCP, r.UDP, strconv.FormatBool(r.AllowIcmp),
+	}
+}
+
+func (r AccessReportRow) withResource(resource *Resource) AccessReportRow {
+	if resource == nil {
+		return r
+	}
+
+	r.ResourceID = resource.ID
+	r.ResourceName = resource.Name
+	r.ResourceAddress = resource.Address
+
+	protocols := resource.Protocols
+	if protocols == nil {
+		protocols = DefaultProtocols()
+	}
+
+	r.TCP = protocols.TCP.describe()
+	r.UDP = protocols.UDP.describe()
+	r.AllowIcmp = protocols.AllowIcmp
+
+	return r
+}
+
+// describe returns the policy with the allowed ports, e.g. `RESTRICTED:80,443-445`.
+func (p *Protocol) describe() string {
+	policy := p.EffectivePolicy()
+	if policy != PolicyRestricted {
+		return policy
+	}
+
+	return policy + ":" + strings.Join(p.PortsToString(), ",")
+}
+
+type AccessReport struct {
+	GeneratedAt time.Time
+	Rows        []AccessReportRow
+}
+
+// NewAccessReport lists every user with the groups they are in and the resources each group grants,
+// followed by the resources granted to service accounts. Inactive groups and resources grant no access,
+// so they are left out. The rows are sorted so that the same access always gives the same report content.
+func NewAccessReport(inventory *AccessInventory, generatedAt time.Time) *AccessReport {
+	return &AccessReport{
+		GeneratedAt: generatedAt.UTC(),
+		Rows:        inventory.accessReportRows(),
+	}
+}
+
+func (inv *AccessInventory) accessReportRows() []AccessReportRow {
+	resources := make(map[string]*Resource, len(inv.Resources))
+	for _, resource := range inv.Resources {
+		resources[resource.ID] = resource
+	}
+
+	policies := make(map[string]string, len(inv.SecurityPolicies))
+	for _, policy := range inv.SecurityPolicies {
+		policies[policy.ID] = policy.Name
+	}
+
+	userGroups := make(map[string][]*Group)
+
+	for _, group := range inv.Groups {
+		if !group.IsActive {
+			continue
+		}
+
+		for _, userID := range group.Users {
+			userGroups[userID] = append(userGroups[userID], group)
+		}
+	}
+
+	rows := make([]AccessReportRow, 0, len(inv.Users))
+
+	for _, user := range inv.Users {
+		principal := AccessReportRow{PrincipalType: PrincipalTypeUser, PrincipalID: user.ID, PrincipalName: user.Email}
+
+		if len(userGroups[user.ID]) == 0 {
+			rows = append(rows, principal)
+
+			continue
+		}
+
+		for _, group := range userGroups[user.ID] {
+			row := principal
+			row.GroupID = group.ID
+			row.GroupName = group.Name
+			row.SecurityPolicyID = group.SecurityPolicyID
+			row.SecurityPolicyName = policies[group.SecurityPolicyID]
+
+			rows = append(rows, grantRows(row, group.Resources, resources)...)
+		}
+	}
+
+	for _, account := range inv.ServiceAccounts {
+		principal := AccessReportRow{PrincipalType: PrincipalTypeServiceAccount, PrincipalID: account.ID, PrincipalName: account.Name}
+		rows = append(rows, grantRows(principal, account.Resources, resources)...)
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return lessRow(rows[i].values(), rows[j].values())
+	})
+
+	return rows
+}
+
+func grantRows(principal AccessReportRow, resourceIDs []string, resources map[string]*Resource) []AccessReportRow {
+	rows := make([]AccessReportRow, 0, len(resourceIDs))
+
+	for _, resourceID := range resourceIDs {
+		resource, ok := resources[resourceID]
+		if !ok {
+			resource = &Resource{ID: resourceID, IsActive: true}
+		}
+
+		if !resource.IsActive {
+			continue
+		}
+
+		rows = append(rows, principal.withResource(resource))
+	}
+
+	if len(rows) == 0 {
+		return []AccessReportRow{principal}
+	}
+
+	return rows
+}
+
+func lessRow(left, right []string) bool {
+	for i := range left {
+		if left[i] != right[i] {
+			return left[i] < right[i]
+		}
+	}
+
+	return false
+}
+
+// CSV returns the rows with a header line, the generation timestamp isn't included so the content only changes with the access.
+func (r *AccessReport) CSV() (string, error) {
+	var buf bytes.Buffer
+
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(accessReportHeader); err != nil {
+		return "", err //nolint
+	}
+
+	for _, row := range r.Rows {
+		if err := writer.Write(row.values()); err != nil {
+			return "", err //nolint
+		}
+	}
+
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		return "", err //nolint
+	}
+
+	return buf.String(), nil
+}
+
+// ContentHash returns the hex encoded SHA-256 of the CSV content.
+func (r *AccessReport) ContentHash() (string, error) {
+	content, err := r.CSV()
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(content))
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// JSON returns the rows together with the generation timestamp and the content hash.
+func (r *AccessReport) JSON() (string, error) {
+	hash, err := r.ContentHash()
+	if err != nil {
+		return "", err
+	}
+
+	content, err := json.Marshal(struct {
+		GeneratedAt string            `json:"generated_at"`
+		ContentHash string            `json:"content_hash"`
+		Rows        []AccessReportRow `json:"rows"`
+	}{
+		GeneratedAt: r.GeneratedAt.Format(time.RFC3339),
+		ContentHash: hash,
+		Rows:        r.Rows,
+	})
+	if err != nil {
+		return "", err //nolint
+	}
+
+	return string(content), nil
+}
@@ -0,0 +1,12 @@
+package model
+
+const (
+	ConnectorTokensStatusValid       = "VALID"
+	ConnectorTokensStatusInvalidated = "INVALIDATED"
+	ConnectorTokensStatusRotationDue = "ROTATION_DUE"
+)
+
+type ConnectorTokens struct {
+	AccessToken  string
+	RefreshToken string
+}
@@ -0,0 +1,66 @@
+package model
+
+import "github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+
+const (
+	ConnectorStateAlive               = "ALIVE"
+	ConnectorStateDeadNoHeartbeat     = "DEAD_NO_HEARTBEAT"
+	ConnectorStateDeadHeartbeatTooOld = "DEAD_HEARTBEAT_TOO_OLD"
+	ConnectorStateDeadNoRelays        = "DEAD_NO_RELAYS"
+)
+
+var ConnectorStates = []string{ConnectorStateAlive, ConnectorStateDeadNoHeartbeat, ConnectorStateDeadHeartbeatTooOld, ConnectorStateDeadNoRelays} //nolint
+
+type Connector struct {
+	ID                   string
+	Name                 string
+	NetworkID            string
+	StatusUpdatesEnabled *bool
+	State                string
+	LastHeartbeatAt      string
+	Version              string
+	Hostname             string
+	PublicIP             string
+	PrivateIPs           []string
+}
+
+func (c Connector) GetName() string {
+	return c.Name
+}
+
+func (c Connector) GetID() string {
+	return c.ID
+}
+
+// IsAlive reports whether the connector is sending heartbeats and can reach the relays.
+func (c Connector) IsAlive() bool {
+	return c.State == ConnectorStateAlive
+}
+
+func (c Connector) ToTerraform() interface{} {
+	return map[string]interface{}{
+		attr.ID:                   c.ID,
+		attr.Name:                 c.Name,
+		attr.RemoteNetworkID:      c.NetworkID,
+		attr.StatusUpdatesEnabled: *c.StatusUpdatesEnabled,
+		attr.State:                c.State,
+		attr.LastHeartbeatAt:      c.LastHeartbeatAt,
+		attr.Version:              c.Version,
+		attr.Hostname:             c.Hostname,
+		attr.PublicIP:             c.PublicIP,
+		attr.PrivateIPs:           c.PrivateIPs,
+	}
+}
+
+// CountAliveConnectors returns how many of the connectors are alive.
+func CountAliveConnectors(connectors []*Connector) int {
+	var count int
+
+	for _, connector := range connectors {
+		if connector.IsAlive() {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,13 @@
+package model
+
+const (
+	// DeletionProtectionNone only protects the objects with deletion protection enabled.
+	DeletionProtectionNone = "NONE"
+	// DeletionProtectionNonEmptyRemoteNetworks also protects the remote networks that still have resources or connectors.
+	DeletionProtectionNonEmptyRemoteNetworks = "NON_EMPTY_REMOTE_NETWORKS"
+	// DeletionProtectionAll protects every object, unless its deletion protection is disabled.
+	DeletionProtectionAll = "ALL"
+)
+
+//nolint:gochecknoglobals
+var DeletionProtectionLevels = []string{DeletionProtectionNone, DeletionProtectionNonEmptyRemoteNetworks, DeletionProtectionAll}
@@ -0,0 +1,200 @@
+package model
+
+import (
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	ProtocolTCP  = "TCP"
+	ProtocolUDP  = "UDP"
+	ProtocolICMP = "ICMP"
+)
+
+//nolint:gochecknoglobals
+var AccessProtocols = []string{ProtocolTCP, ProtocolUDP, ProtocolICMP}
+
+// EffectiveAccessQuery is the traffic to evaluate, no port means any port and no protocol means any protocol.
+type EffectiveAccessQuery struct {
+	Address  string
+	Port     int
+	Protocol string
+}
+
+// EffectiveAccess lists the active resources the traffic can reach and who is granted access to them.
+type EffectiveAccess struct {
+	Resources       []*Resource
+	Groups          []string
+	Users           []string
+	ServiceAccounts []string
+}
+
+// EffectiveAccess evaluates the query against every active resource, only active groups grant access.
+func (inv *AccessInventory) EffectiveAccess(query EffectiveAccessQuery) *EffectiveAccess {
+	access := &EffectiveAccess{}
+	matched := make(map[string]bool)
+
+	for _, resource := range inv.Resources {
+		if !resource.IsActive || !AddressMatches(resource.Address, query.Address) ||
+			!resource.Protocols.Allows(query.Protocol, query.Port) {
+			continue
+		}
+
+		access.Resources = append(access.Resources, resource)
+		matched[resource.ID] = true
+	}
+
+	groups := make(map[string]bool)
+	users := make(map[string]bool)
+
+	for _, group := range inv.Groups {
+		if !group.IsActive || !containsAny(group.Resources, matched) {
+			continue
+		}
+
+		groups[group.ID] = true
+
+		for _, userID := range group.Users {
+			users[userID] = true
+		}
+	}
+
+	accounts := make(map[string]bool)
+
+	for _, account := range inv.ServiceAccounts {
+		if containsAny(account.Resources, matched) {
+			accounts[account.ID] = true
+		}
+	}
+
+	sort.SliceStable(access.Resources, func(i, j int) bool {
+		return access.Resources[i].ID < access.Resources[j].ID
+	})
+
+	access.Groups = sortedKeys(groups)
+	access.Users = sortedKeys(users)
+	access.ServiceAccounts = sortedKeys(accounts)
+
+	return access
+}
+
+func containsAny(ids []string, set map[string]bool) bool {
+	for _, id := range ids {
+		if set[id] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// AddressMatches reports whether the address, an IP, CIDR or hostname, is covered by the resource address,
+// which can be an IP, CIDR, FQDN or a DNS zone with `*` and `?` wildcards.
+// A CIDR address matches the IP and CIDR resources it overlaps.
+func AddressMatches(resourceAddress, address string) bool {
+	resourceAddress = normalizeHost(resourceAddress)
+	address = normalizeHost(address)
+
+	if resourceAddress == "" || address == "" {
+		return false
+	}
+
+	resourceNetwork := parseNetwork(resourceAddress)
+	network := parseNetwork(address)
+
+	switch {
+	case resourceNetwork != nil && network != nil:
+		return resourceNetwork.Contains(network.IP) || network.Contains(resourceNetwork.IP)
+	case resourceNetwork != nil || network != nil:
+		return false
+	case strings.ContainsAny(resourceAddress, "*?"):
+		return wildcardPattern(resourceAddress).MatchString(address)
+	default:
+		return resourceAddress == address
+	}
+}
+
+func normalizeHost(address string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(address)), ".")
+}
+
+// parseNetwork returns the IP or CIDR as a network, an IP is a single address network.
+func parseNetwork(address string) *net.IPNet {
+	if _, network, err := net.ParseCIDR(address); err == nil {
+		return network
+	}
+
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return nil
+	}
+
+	bits := 8 * net.IPv6len
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+		bits = 8 * net.IPv4len
+	}
+
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+}
+
+func wildcardPattern(zone string) *regexp.Regexp {
+	pattern := regexp.QuoteMeta(zone)
+	pattern = strings.ReplaceAll(pattern, `\*`, `.*`)
+	pattern = strings.ReplaceAll(pattern, `\?`, `.`)
+
+	return regexp.MustCompile("^" + pattern + "$")
+}
+
+// Allows reports whether the protocols allow the traffic, no protocols means everything is allowed.
+// An empty protocol matches TCP or UDP, a zero port matches any allowed port.
+func (p *Protocols) Allows(protocol string, port int) bool {
+	if p == nil {
+		return true
+	}
+
+	switch protocol {
+	case ProtocolICMP:
+		return p.AllowIcmp
+	case ProtocolTCP:
+		return p.TCP.allowsPort(port)
+	case ProtocolUDP:
+		return p.UDP.allowsPort(port)
+	default:
+		return p.TCP.allowsPort(port) || p.UDP.allowsPort(port) || (port == 0 && p.AllowIcmp)
+	}
+}
+
+func (p *Protocol) allowsPort(port int) bool {
+	switch p.EffectivePolicy() {
+	case PolicyAllowAll:
+		return true
+	case PolicyDenyAll:
+		return false
+	}
+
+	if port == 0 {
+		return true
+	}
+
+	for _, portRange := range p.Ports {
+		if portRange != nil && portRange.Start <= port && port <= portRange.End {
+			return true
+		}
+	}
+
+	return false
+}
@@ -5,7 +5,12 @@ import (
 	"fmt"
 )
 
-var ErrInvalidPortRangeLen = errors.New("port range expects 2 values")
+var (
+	ErrInvalidPortRangeLen = errors.New("port range expects 2 values")
+	ErrEmptyDNSLabel       = errors.New("it has an empty label")
+	ErrNumericDNSName      = errors.New("all its labels are numeric, it's neither an IP nor a DNS name")
+	ErrRestrictedNoPorts   = errors.New("policy RESTRICTED allows no port, set the ports to allow or use DENY_ALL")
+)
 
 func ErrInvalidPortRange(portRange string, err error) error {
 	return fmt.Errorf(`failed to parse protocols port range "%s": %w`, portRange, err)
@@ -40,3 +45,23 @@ func NewPortRangeNotRisingSequenceError(start, end int) *PortRangeNotRisingSeque
 func (e *PortRangeNotRisingSequenceError) Error() string {
 	return fmt.Sprintf("ports %d, %d needs to be in a rising sequence", e.Start, e.End)
 }
+
+type InvalidAddressError struct {
+	Address string
+	Reason  string
+}
+
+func NewInvalidAddressError(address, reason string) *InvalidAddressError {
+	return &InvalidAddressError{
+		Address: address,
+		Reason:  reason,
+	}
+}
+
+func (e *InvalidAddressError) Error() string {
+	return fmt.Sprintf("address `%s` is invalid: %s", e.Address, e.Reason)
+}
+
+func NewInvalidAliasError(alias string, err error) error {
+	return fmt.Errorf("alias `%s` is not a valid DNS name: %w", alias, err)
+}
@@ -14,6 +14,7 @@ type Group struct {
 	Type             string
 	IsActive         bool
 	Users            []string
+	Resources        []string
 	IsAuthoritative  bool
 	SecurityPolicyID string
 }
@@ -33,6 +34,8 @@ func (g Group) ToTerraform() interface{} {
 		attr.Type:             g.Type,
 		attr.IsActive:         g.IsActive,
 		attr.SecurityPolicyID: g.SecurityPolicyID,
+		attr.UserIDs:          g.Users,
+		attr.ResourceIDs:      g.Resources,
 	}
 }
 
@@ -0,0 +1,96 @@
+package model
+
+import (
+	"net"
+	"strings"
+)
+
+// ResourcesFilter selects resources, the name filters are applied by the API
+// and the others by MatchResource.
+type ResourcesFilter struct {
+	Name              *string
+	NamePrefix        *string
+	NameSuffix        *string
+	NameRegexp        *string
+	RemoteNetworkID   *string
+	AddressContains   *string
+	AddressWithinCIDR *string
+	ProtocolPolicy    *string
+	GroupID           *string
+	ServiceAccountID  *string
+}
+
+func (f *ResourcesFilter) HasNameFilter() bool {
+	return f != nil && (f.Name != nil || f.NamePrefix != nil || f.NameSuffix != nil || f.NameRegexp != nil)
+}
+
+// MatchResource reports whether the resource matches the filters the API doesn't support.
+// The access filters need the resource groups and service accounts, which the resources list doesn't include,
+// so they are applied by the client.
+func (f *ResourcesFilter) MatchResource(resource *Resource) bool {
+	if f == nil {
+		return true
+	}
+
+	if f.RemoteNetworkID != nil && resource.RemoteNetworkID != *f.RemoteNetworkID {
+		return false
+	}
+
+	if f.AddressContains != nil && !strings.Contains(resource.Address, *f.AddressContains) {
+		return false
+	}
+
+	if f.AddressWithinCIDR != nil && !AddressWithinCIDR(resource.Address, *f.AddressWithinCIDR) {
+		return false
+	}
+
+	if f.ProtocolPolicy != nil && !resource.Protocols.HasPolicy(*f.ProtocolPolicy) {
+		return false
+	}
+
+	return true
+}
+
+// AddressWithinCIDR reports whether the IP or CIDR address is inside the CIDR block,
+// FQDN addresses are never inside one.
+func AddressWithinCIDR(address, cidr string) bool {
+	_, block, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false
+	}
+
+	if ip := net.ParseIP(address); ip != nil {
+		return block.Contains(ip)
+	}
+
+	ip, network, err := net.ParseCIDR(address)
+	if err != nil {
+		return false
+	}
+
+	blockOnes, _ := block.Mask.Size()
+	networkOnes, _ := network.Mask.Size()
+
+	return block.Contains(ip) && networkOnes >= blockOnes
+}
+
+// HasPolicy reports whether the TCP or UDP policy is the given one, no protocols means everything is allowed.
+func (p *Protocols) HasPolicy(policy string) bool {
+	if p == nil {
+		return policy == PolicyAllowAll
+	}
+
+	return p.TCP.EffectivePolicy() == policy || p.UDP.EffectivePolicy() == policy
+}
+
+// EffectivePolicy returns the policy as configured, the API stores DENY_ALL as RESTRICTED without ports.
+func (p *Protocol) EffectivePolicy() string {
+	switch {
+	case p == nil:
+		return PolicyAllowAll
+	case p.Policy == PolicyRestricted && len(p.Ports) == 0:
+		return PolicyDenyAll
+	default:
+		return p.Policy
+	}
+}
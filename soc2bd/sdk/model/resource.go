@@ -2,6 +2,7 @@ package model
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
@@ -137,6 +138,39 @@ func newPortRange(str string) (*PortRange, error) {
 	}, nil
 }
 
+// MergePortRanges returns the canonical form of the port ranges: sorted by start port,
+// with overlapping and adjacent ranges collapsed, e.g. `80`, `80-90`, `91` becomes `80-91`.
+func MergePortRanges(ports []*PortRange) []*PortRange {
+	sorted := make([]*PortRange, 0, len(ports))
+
+	for _, port := range ports {
+		if port != nil {
+			sorted = append(sorted, &PortRange{Start: port.Start, End: port.End})
+		}
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Start < sorted[j].Start
+	})
+
+	merged := make([]*PortRange, 0, len(sorted))
+
+	for _, port := range sorted {
+		last := len(merged) - 1
+		if last >= 0 && port.Start <= merged[last].End+1 {
+			if port.End > merged[last].End {
+				merged[last].End = port.End
+			}
+
+			continue
+		}
+
+		merged = append(merged, port)
+	}
+
+	return merged
+}
+
 type Protocol struct {
 	Ports  []*PortRange
 	Policy string
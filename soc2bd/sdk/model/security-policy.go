@@ -0,0 +1,62 @@
+package model
+
+import "github.com/bangladesh-data/terraform-provider-soc2bd/soc2bd/internal/attr"
+
+const (
+	MFAMethodTOTP     = "TOTP"
+	MFAMethodPush     = "PUSH"
+	MFAMethodWebAuthn = "WEBAUTHN"
+
+	OperatingSystemWindows  = "WINDOWS"
+	OperatingSystemMacOS    = "MACOS"
+	OperatingSystemLinux    = "LINUX"
+	OperatingSystemIOS      = "IOS"
+	OperatingSystemAndroid  = "ANDROID"
+	OperatingSystemChromeOS = "CHROMEOS"
+)
+
+//nolint:gochecknoglobals
+var (
+	MFAMethods       = []string{MFAMethodTOTP, MFAMethodPush, MFAMethodWebAuthn}
+	OperatingSystems = []string{
+		OperatingSystemWindows, OperatingSystemMacOS, OperatingSystemLinux,
+		OperatingSystemIOS, OperatingSystemAndroid, OperatingSystemChromeOS,
+	}
+)
+
+type SecurityPolicy struct {
+	ID                   string
+	Name                 string
+	RequireMFA           bool
+	MFAMethods           []string
+	SessionLifetimeHours int
+	DevicePosture        *DevicePosture
+	Groups               []string
+}
+
+// DevicePosture lists the checks a device must pass to connect.
+type DevicePosture struct {
+	RequireScreenLock       bool
+	RequireDiskEncryption   bool
+	RequireFirewall         bool
+	AllowedOperatingSystems []string
+}
+
+func (p *DevicePosture) IsEmpty() bool {
+	return p == nil || !p.RequireScreenLock && !p.RequireDiskEncryption && !p.RequireFirewall && len(p.AllowedOperatingSystems) == 0
+}
+
+func (s SecurityPolicy) GetID() string {
+	return s.ID
+}
+
+func (s SecurityPolicy) GetName() string {
+	return s.Name
+}
+
+func (s SecurityPolicy) ToTerraform() interface{} {
+	return map[string]interface{}{
+		attr.ID:   s.ID,
+		attr.Name: s.Name,
+	}
+}
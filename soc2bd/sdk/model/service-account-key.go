@@ -0,0 +1,56 @@
+package model
+
+import "time"
+
+const (
+	StatusActive  = "ACTIVE"
+	StatusRevoked = "REVOKED"
+	StatusExpired = "EXPIRED"
+)
+
+type ServiceKey struct {
+	ID             string
+	Name           string
+	Status         string
+	Service        string
+	ExpirationTime int
+	// ExpiresAt is the RFC3339 expiration time, empty when the key never expires.
+	ExpiresAt string
+	Token     string
+}
+
+func (s ServiceKey) GetName() string {
+	return s.Name
+}
+
+func (s ServiceKey) GetID() string {
+	return s.ID
+}
+
+func (s ServiceKey) IsActive() bool {
+	return s.Status == StatusActive
+}
+
+// IsExpired checks whether the key expiration time has passed.
+func (s ServiceKey) IsExpired() bool {
+	if s.Status == StatusExpired {
+		return true
+	}
+
+	if s.ExpiresAt == "" {
+		return false
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, s.ExpiresAt)
+
+	return err == nil && !expiresAt.After(time.Now())
+}
+
+// GetStatus returns the key status, reporting active keys past their expiration time as expired.
+func (s ServiceKey) GetStatus() string {
+	if s.IsExpired() {
+		return StatusExpired
+	}
+
+	return s.Status
+}
@@ -18,8 +18,9 @@ const (
 
 //nolint:gochecknoglobals
 var (
-	UserRoles = []string{UserRoleAdmin, UserRoleDevops, UserRoleSupport, UserRoleMember}
-	UserTypes = []string{UserTypeManual, UserTypeSynced}
+	UserRoles  = []string{UserRoleAdmin, UserRoleDevops, UserRoleSupport, UserRoleMember}
+	UserTypes  = []string{UserTypeManual, UserTypeSynced}
+	UserStates = []string{UserStateActive, UserStatePending, UserStateDisabled}
 )
 
 type User struct {
@@ -31,6 +32,7 @@ type User struct {
 	Type       string
 	SendInvite bool
 	IsActive   bool
+	Groups     []string
 }
 
 func (u User) GetID() string {
@@ -55,6 +57,7 @@ func (u User) ToTerraform() interface{} {
 		attr.IsAdmin:   u.IsAdmin(),
 		attr.Role:      u.Role,
 		attr.Type:      u.Type,
+		attr.GroupIDs:  u.Groups,
 	}
 }
 
@@ -85,3 +88,14 @@ func (u UserUpdate) State() string {
 
 	return UserStateDisabled
 }
+
+// UsersFilter selects users, all the filters are applied by the API.
+type UsersFilter struct {
+	Role        *string
+	Type        *string
+	State       *string
+	EmailRegexp *string
+	EmailDomain *string
+	FirstName   *string
+	LastName    *string
+}
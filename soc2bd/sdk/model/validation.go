@@ -0,0 +1,152 @@
+package model
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const (
+	minPortValue = 0
+	maxPortValue = 65535
+)
+
+func validatePort(str string) (int, error) {
+	port, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("port `%s` is not a valid integer: %w", str, err)
+	}
+
+	if port < minPortValue || port > maxPortValue {
+		return 0, NewPortNotInRangeError(int(port))
+	}
+
+	return int(port), nil
+}
+
+const (
+	AddressTypeIPv4     = "IPV4"
+	AddressTypeIPv6     = "IPV6"
+	AddressTypeCIDR     = "CIDR"
+	AddressTypeFQDN     = "FQDN"
+	AddressTypeWildcard = "WILDCARD"
+
+	maxDNSNameLength  = 253
+	maxDNSLabelLength = 63
+	wildcardChars     = "*?"
+)
+
+//nolint:gochecknoglobals
+var AddressTypes = []string{AddressTypeIPv4, AddressTypeIPv6, AddressTypeCIDR, AddressTypeFQDN, AddressTypeWildcard}
+
+// ClassifyAddress returns the type of the resource address, an IP, a CIDR block without host bits,
+// an FQDN or a DNS zone with `*` and `?` wildcards.
+func ClassifyAddress(address string) (string, error) {
+	switch {
+	case address == "":
+		return "", NewInvalidAddressError(address, "it's empty")
+	case strings.Contains(address, "/"):
+		return AddressTypeCIDR, validateCIDR(address)
+	case net.ParseIP(address) != nil && strings.Contains(address, ":"):
+		return AddressTypeIPv6, nil
+	case net.ParseIP(address) != nil:
+		return AddressTypeIPv4, nil
+	case strings.ContainsAny(address, wildcardChars):
+		return AddressTypeWildcard, invalidAddress(address, validateDNSName(address, true))
+	default:
+		return AddressTypeFQDN, invalidAddress(address, validateDNSName(address, false))
+	}
+}
+
+func invalidAddress(address string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return NewInvalidAddressError(address, err.Error())
+}
+
+func validateCIDR(address string) error {
+	ip, network, err := net.ParseCIDR(address)
+	if err != nil {
+		return NewInvalidAddressError(address, "it's not a valid CIDR block")
+	}
+
+	if !ip.Equal(network.IP) {
+		return NewInvalidAddressError(address, fmt.Sprintf("it has host bits set, the CIDR block is `%s`", network))
+	}
+
+	return nil
+}
+
+// ValidateAlias checks the alias is a DNS name, wildcards are not allowed.
+func ValidateAlias(alias string) error {
+	if alias == "" {
+		return NewInvalidAliasError(alias, ErrEmptyDNSLabel)
+	}
+
+	if err := validateDNSName(alias, false); err != nil {
+		return NewInvalidAliasError(alias, err)
+	}
+
+	return nil
+}
+
+// validateDNSName checks the name follows the DNS label rules: labels up to 63 letters, digits, hyphens and
+// underscores, not starting or ending with a hyphen, and a name up to 253 characters. Underscores aren't valid
+// in host names but are common in internal DNS, e.g. `_sip._tcp.corp.com`.
+func validateDNSName(name string, allowWildcards bool) error {
+	name = strings.TrimSuffix(name, ".")
+
+	if len(name) > maxDNSNameLength {
+		return fmt.Errorf("it's longer than %d characters", maxDNSNameLength) //nolint:goerr113
+	}
+
+	numeric := true
+
+	for _, label := range strings.Split(name, ".") {
+		if err := validateDNSLabel(label, allowWildcards); err != nil {
+			return err
+		}
+
+		numeric = numeric && isNumeric(label)
+	}
+
+	if numeric {
+		return ErrNumericDNSName
+	}
+
+	return nil
+}
+
+func validateDNSLabel(label string, allowWildcards bool) error {
+	switch {
+	case label == "":
+		return ErrEmptyDNSLabel
+	case len(label) > maxDNSLabelLength:
+		return fmt.Errorf("label `%s` is longer than %d characters", label, maxDNSLabelLength) //nolint:goerr113
+	case strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-"):
+		return fmt.Errorf("label `%s` starts or ends with a hyphen", label) //nolint:goerr113
+	}
+
+	for _, char := range label {
+		if isDNSLabelChar(char) || (allowWildcards && strings.ContainsRune(wildcardChars, char)) {
+			continue
+		}
+
+		return fmt.Errorf("label `%s` has the invalid character `%c`", label, char) //nolint:goerr113
+	}
+
+	return nil
+}
+
+func isDNSLabelChar(char rune) bool {
+	return char == '-' || char == '_' || ('a' <= char && char <= 'z') || ('A' <= char && char <= 'Z') || ('0' <= char && char <= '9')
+}
+
+func isNumeric(str string) bool {
+	_, err := strconv.Atoi(str)
+
+	return err == nil
+}
@@ -0,0 +1,17 @@
+// Package sdk is the Go SDK for the Soc2bd API.
+//
+// The API client lives in the client package and the typed models it returns
+// in the model package:
+//
+//	c := client.NewClient(
+//		client.WithNetwork("autoco"),
+//		client.WithAPIToken(os.Getenv("SOC2BD_API_TOKEN")),
+//	)
+//
+//	resources, err := c.ReadResources(ctx)
+//
+// The SDK follows semantic versioning, see Version.
+package sdk
+
+// Version of the SDK.
+const Version = "1.0.0"